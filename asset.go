@@ -13,16 +13,38 @@ type Asset struct {
 	Name        string
 }
 
-// SingleAssetFromData returns an Asset from the passed in data
-func SingleAssetFromData(data map[string]interface{}) Asset {
-	attributes := data["attributes"].(map[string]interface{})
+// assetAttributes are the attributes of an asset resource.
+type assetAttributes struct {
+	URL         string `json:"URL"`
+	CreatedAt   string `json:"createdAt"`
+	Description string `json:"description"`
+	Name        string `json:"name"`
+}
+
+// SingleAssetFromResource returns an Asset from the passed in resource
+func SingleAssetFromResource(resource Resource) (Asset, error) {
+	attributes := assetAttributes{}
+	if err := resource.decodeAttributes(&attributes); err != nil {
+		return Asset{}, err
+	}
 
 	return Asset{
-		Type:        data["type"].(string),
-		ID:          data["id"].(string),
-		URL:         attributes["URL"].(string),
-		CreatedAt:   attributes["createdAt"].(string),
-		Description: attributes["description"].(string),
-		Name:        attributes["name"].(string),
+		Type:        resource.Type,
+		ID:          resource.ID,
+		URL:         attributes.URL,
+		CreatedAt:   attributes.CreatedAt,
+		Description: attributes.Description,
+		Name:        attributes.Name,
+	}, nil
+}
+
+// SingleAssetFromData returns a single Asset from the decoded JSON of a resource.
+//
+// Deprecated: use SingleAssetFromResource.
+func SingleAssetFromData(data map[string]interface{}) (Asset, error) {
+	resource, err := resourceFromData(data)
+	if err != nil {
+		return Asset{}, err
 	}
+	return SingleAssetFromResource(resource)
 }
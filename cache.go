@@ -0,0 +1,186 @@
+package battleritego
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Cache stores responses of a Client by URL, see WithCache.
+// Implementations must be safe for concurrent use. The Client stores the expiry and the
+// ETag and Last-Modified validators of each response in the value itself, so a Cache may
+// keep values for as long as it likes.
+type Cache interface {
+	Get(key string) ([]byte, bool)
+	Set(key string, value []byte)
+	Delete(key string)
+}
+
+// CacheForever is a cache TTL for responses that never expire.
+const CacheForever time.Duration = -1
+
+// CacheTTLs sets how long each kind of response is cached.
+// A TTL of zero disables caching of that kind, CacheForever caches it forever.
+// Once an ETag or Last-Modified header was received with a response, it is revalidated
+// after it expires with a conditional request, which costs no response body if the
+// response didn't change.
+type CacheTTLs struct {
+	// Match is a single match by ID, Matches is a page of matches searched with a filter.
+	Match     time.Duration
+	Matches   time.Duration
+	Players   time.Duration
+	Teams     time.Duration
+	Status    time.Duration
+	Telemetry time.Duration
+}
+
+// DefaultCacheTTLs caches finished matches and telemetry forever, as they never change,
+// players and teams for a few minutes and never caches match searches or the status.
+var DefaultCacheTTLs = CacheTTLs{
+	Match:     CacheForever,
+	Players:   5 * time.Minute,
+	Teams:     5 * time.Minute,
+	Telemetry: CacheForever,
+}
+
+// cacheEntry is the header stored in front of each cached response.
+type cacheEntry struct {
+	URL string `json:"url"`
+	// Expires is the expiry in Unix nanoseconds, zero means the entry never expires.
+	Expires      int64  `json:"expires"`
+	ETag         string `json:"etag,omitempty"`
+	LastModified string `json:"lastModified,omitempty"`
+}
+
+// expired reports whether the entry has expired at now.
+func (entry cacheEntry) expired(now time.Time) bool {
+	return entry.Expires != 0 && now.UnixNano() >= entry.Expires
+}
+
+// conditions returns the headers of a conditional request revalidating the entry.
+func (entry cacheEntry) conditions() http.Header {
+	header := http.Header{}
+	if entry.ETag != "" {
+		header.Set("If-None-Match", entry.ETag)
+	}
+	if entry.LastModified != "" {
+		header.Set("If-Modified-Since", entry.LastModified)
+	}
+	return header
+}
+
+// encodeCacheEntry returns the cache value of page, a line of JSON holding entry
+// followed by the page itself.
+func encodeCacheEntry(entry cacheEntry, page []byte) []byte {
+	header, _ := json.Marshal(entry)
+
+	value := make([]byte, 0, len(header)+1+len(page))
+	value = append(value, header...)
+	value = append(value, '\n')
+	return append(value, page...)
+}
+
+// decodeCacheEntry splits a cache value back into its entry and page.
+func decodeCacheEntry(value []byte) (cacheEntry, []byte, error) {
+	i := bytes.IndexByte(value, '\n')
+	if i < 0 {
+		return cacheEntry{}, nil, errors.New("battleritego: malformed cache entry")
+	}
+
+	entry := cacheEntry{}
+	if err := json.Unmarshal(value[:i], &entry); err != nil {
+		return cacheEntry{}, nil, err
+	}
+	return entry, value[i+1:], nil
+}
+
+// pageTTL returns how long the API page at URL is cached, zero if it isn't.
+func (client Client) pageTTL(URL string) time.Duration {
+	if client.cache == nil {
+		return 0
+	}
+
+	if URL == client.rootURL()+"status" {
+		return client.cacheTTLs.Status
+	}
+
+	path := strings.TrimPrefix(URL, client.baseURL())
+	if path == URL {
+		return 0
+	}
+	switch {
+	case strings.HasPrefix(path, "matches/"):
+		return client.cacheTTLs.Match
+	case strings.HasPrefix(path, "matches"):
+		return client.cacheTTLs.Matches
+	case strings.HasPrefix(path, "players"):
+		return client.cacheTTLs.Players
+	case strings.HasPrefix(path, "teams"):
+		return client.cacheTTLs.Teams
+	}
+	return 0
+}
+
+// cachedPageBytes is like fetchPageBytes but returns the cached page if there is one and
+// caches the page for ttl otherwise. Cached pages are returned with an empty header.
+// An expired page with validators is revalidated, a not modified response returns the
+// cached page with the header of that response.
+func (client Client) cachedPageBytes(ctx context.Context, URL string, ttl time.Duration) ([]byte, http.Header, error) {
+	if client.cache == nil || ttl == 0 {
+		page, r, err := client.fetchPageBytes(ctx, URL, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		return page, r.Header, nil
+	}
+
+	now := time.Now()
+	var stale *cacheEntry
+	var stalePage []byte
+	if value, ok := client.cache.Get(URL); ok {
+		entry, page, err := decodeCacheEntry(value)
+		if err == nil && entry.URL == URL {
+			if !entry.expired(now) {
+				return page, http.Header{}, nil
+			}
+			if entry.ETag != "" || entry.LastModified != "" {
+				stale, stalePage = &entry, page
+			}
+		}
+		if stale == nil {
+			client.cache.Delete(URL)
+		}
+	}
+
+	var conditions http.Header
+	if stale != nil {
+		conditions = stale.conditions()
+	}
+
+	page, r, err := client.fetchPageBytes(ctx, URL, conditions)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	entry := cacheEntry{
+		URL:          URL,
+		ETag:         r.Header.Get("ETag"),
+		LastModified: r.Header.Get("Last-Modified"),
+	}
+	if r.StatusCode == http.StatusNotModified && stale != nil {
+		page = stalePage
+		if entry.ETag == "" && entry.LastModified == "" {
+			entry.ETag, entry.LastModified = stale.ETag, stale.LastModified
+		}
+	}
+	if ttl > 0 {
+		entry.Expires = now.Add(ttl).UnixNano()
+	}
+	client.cache.Set(URL, encodeCacheEntry(entry, page))
+
+	return page, r.Header, nil
+}
@@ -0,0 +1,108 @@
+package battleritego
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// FileCache is a Cache storing each value in a file of a directory, so cached responses
+// survive restarts. Files are named after the SHA-256 hash of their key.
+// Errors reading or writing files are treated as cache misses.
+// FileCache is a StreamingCache, so telemetry is streamed to and from its files.
+type FileCache struct {
+	dir string
+}
+
+// NewFileCache returns a FileCache storing values in dir, which is created when needed.
+func NewFileCache(dir string) *FileCache {
+	return &FileCache{dir: dir}
+}
+
+// path returns the path of the file holding the value of key.
+func (cache *FileCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(cache.dir, hex.EncodeToString(sum[:]))
+}
+
+// Get returns the value of key.
+func (cache *FileCache) Get(key string) ([]byte, bool) {
+	value, err := ioutil.ReadFile(cache.path(key))
+	if err != nil {
+		return nil, false
+	}
+	return value, true
+}
+
+// Set stores value under key. The value is written to a temporary file first and then
+// renamed, so a concurrent Get never sees a partly written value.
+func (cache *FileCache) Set(key string, value []byte) {
+	w, err := cache.Create(key)
+	if err != nil {
+		return
+	}
+	if _, err := w.Write(value); err != nil {
+		w.Abort()
+		return
+	}
+	w.Commit()
+}
+
+// Open returns a reader of the file holding the value of key.
+func (cache *FileCache) Open(key string) (io.ReadCloser, bool) {
+	file, err := os.Open(cache.path(key))
+	if err != nil {
+		return nil, false
+	}
+	return file, true
+}
+
+// Create returns a writer of a new value of key, written to a temporary file that
+// replaces the file of key on Commit.
+func (cache *FileCache) Create(key string) (CacheWriter, error) {
+	if err := os.MkdirAll(cache.dir, 0755); err != nil {
+		return nil, err
+	}
+
+	file, err := ioutil.TempFile(cache.dir, ".tmp-")
+	if err != nil {
+		return nil, err
+	}
+	return &fileCacheWriter{file: file, path: cache.path(key)}, nil
+}
+
+// fileCacheWriter writes a value of a FileCache to a temporary file.
+type fileCacheWriter struct {
+	file *os.File
+	path string
+}
+
+func (w *fileCacheWriter) Write(p []byte) (int, error) {
+	return w.file.Write(p)
+}
+
+// Commit closes the temporary file and renames it to the file of the key.
+func (w *fileCacheWriter) Commit() error {
+	err := w.file.Close()
+	if err == nil {
+		err = os.Rename(w.file.Name(), w.path)
+	}
+	if err != nil {
+		os.Remove(w.file.Name())
+	}
+	return err
+}
+
+// Abort closes and removes the temporary file.
+func (w *fileCacheWriter) Abort() {
+	w.file.Close()
+	os.Remove(w.file.Name())
+}
+
+// Delete removes the value of key.
+func (cache *FileCache) Delete(key string) {
+	os.Remove(cache.path(key))
+}
@@ -0,0 +1,100 @@
+package battleritego
+
+import (
+	"container/list"
+	"sync"
+)
+
+// MemoryCache is a Cache holding values in memory, evicting the least recently used
+// values once it holds more than its maximum number of values or bytes.
+// Telemetry is large, so a cache holding it should be bounded by bytes.
+type MemoryCache struct {
+	mu         sync.Mutex
+	maxEntries int
+	maxBytes   int64
+	size       int64
+	order      *list.List
+	entries    map[string]*list.Element
+}
+
+// memoryCacheItem is a value of a MemoryCache along with its key.
+type memoryCacheItem struct {
+	key   string
+	value []byte
+}
+
+// NewMemoryCache returns a MemoryCache holding at most maxEntries values and maxBytes bytes
+// of values, zero means no limit. Values larger than maxBytes are not stored.
+func NewMemoryCache(maxEntries int, maxBytes int64) *MemoryCache {
+	return &MemoryCache{
+		maxEntries: maxEntries,
+		maxBytes:   maxBytes,
+		order:      list.New(),
+		entries:    map[string]*list.Element{},
+	}
+}
+
+// Get returns the value of key, marking it as recently used.
+func (cache *MemoryCache) Get(key string) ([]byte, bool) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	element, ok := cache.entries[key]
+	if !ok {
+		return nil, false
+	}
+	cache.order.MoveToFront(element)
+	return element.Value.(*memoryCacheItem).value, true
+}
+
+// Set stores value under key, evicting the least recently used values if the cache is full.
+func (cache *MemoryCache) Set(key string, value []byte) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	cache.remove(key)
+	if cache.maxBytes > 0 && int64(len(value)) > cache.maxBytes {
+		return
+	}
+
+	cache.entries[key] = cache.order.PushFront(&memoryCacheItem{key, value})
+	cache.size += int64(len(value))
+
+	for (cache.maxEntries > 0 && cache.order.Len() > cache.maxEntries) ||
+		(cache.maxBytes > 0 && cache.size > cache.maxBytes) {
+		cache.remove(cache.order.Back().Value.(*memoryCacheItem).key)
+	}
+}
+
+// Delete removes the value of key.
+func (cache *MemoryCache) Delete(key string) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	cache.remove(key)
+}
+
+// remove removes the value of key, the cache must be locked.
+func (cache *MemoryCache) remove(key string) {
+	if element, ok := cache.entries[key]; ok {
+		cache.order.Remove(element)
+		delete(cache.entries, key)
+		cache.size -= int64(len(element.Value.(*memoryCacheItem).value))
+	}
+}
+
+// Len returns the number of values in the cache.
+func (cache *MemoryCache) Len() int {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	return cache.order.Len()
+}
+
+// Size returns the number of bytes of values in the cache.
+func (cache *MemoryCache) Size() int64 {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	return cache.size
+}
@@ -0,0 +1,233 @@
+package battleritego
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"time"
+)
+
+// maxCacheDrain is how much of a telemetry body left unread after its closing bracket is
+// still read on close so it can be cached. Only whitespace may follow the bracket.
+const maxCacheDrain = 64 << 10
+
+// StreamingCache is a Cache that can also read and write values as streams, so large
+// values such as telemetry are never held in memory as a whole. FileCache implements it.
+type StreamingCache interface {
+	Cache
+	// Open returns a reader of the value of key.
+	Open(key string) (io.ReadCloser, bool)
+	// Create returns a writer storing a new value of key once it is committed.
+	Create(key string) (CacheWriter, error)
+}
+
+// CacheWriter writes a value of a StreamingCache. The value replaces any earlier value of
+// its key on Commit, Abort discards it.
+type CacheWriter interface {
+	io.Writer
+	Commit() error
+	Abort()
+}
+
+// bufferedCacheWriter is the CacheWriter used for caches that aren't a StreamingCache,
+// collecting the value in memory until it is committed.
+type bufferedCacheWriter struct {
+	cache Cache
+	key   string
+	buf   bytes.Buffer
+}
+
+func (w *bufferedCacheWriter) Write(p []byte) (int, error) {
+	return w.buf.Write(p)
+}
+
+// Commit stores the collected value.
+func (w *bufferedCacheWriter) Commit() error {
+	w.cache.Set(w.key, w.buf.Bytes())
+	return nil
+}
+
+// Abort discards the collected value.
+func (w *bufferedCacheWriter) Abort() {
+	w.buf.Reset()
+}
+
+// telemetryBody is the body of a telemetry download, copied into the clients Cache as it is read.
+// The copy is only committed once the caller decoded the whole body, see complete.
+// A body served from the cache is removed from it if the caller discards it.
+type telemetryBody struct {
+	io.ReadCloser
+	w        CacheWriter
+	eof      bool
+	complete bool
+	cache    Cache
+	key      string
+}
+
+func (body *telemetryBody) Read(p []byte) (int, error) {
+	n, err := body.ReadCloser.Read(p)
+	if body.w != nil && n > 0 {
+		if _, werr := body.w.Write(p[:n]); werr != nil {
+			body.w.Abort()
+			body.w = nil
+		}
+	}
+	if err == io.EOF {
+		body.eof = true
+	} else if err != nil {
+		body.discard()
+	}
+	return n, err
+}
+
+// finish marks the body as decoded up to its closing bracket, so it may be cached.
+func (body *telemetryBody) finish() {
+	body.complete = true
+}
+
+// discard stops caching the body, or removes it from the cache if it was served from it.
+// It is called when the body turned out to be unusable.
+func (body *telemetryBody) discard() {
+	if body.w != nil {
+		body.w.Abort()
+		body.w = nil
+	}
+	if body.cache != nil {
+		body.cache.Delete(body.key)
+		body.cache = nil
+	}
+}
+
+// Close caches the body if it was decoded completely and closes it.
+// Bodies that were only partly decoded, such as when a StreamTelemetry callback stopped
+// early, are never cached, as the rest of the body was never checked.
+func (body *telemetryBody) Close() error {
+	if body.w != nil && body.complete && !body.eof && !body.drainWhitespace() {
+		body.complete = false
+	}
+	if body.w != nil {
+		if body.complete && body.eof {
+			body.w.Commit()
+		} else {
+			body.w.Abort()
+		}
+		body.w = nil
+	}
+	return body.ReadCloser.Close()
+}
+
+// drainWhitespace reads the rest of a decoded body into the cache, reporting whether it
+// was nothing but whitespace up to EOF.
+func (body *telemetryBody) drainWhitespace() bool {
+	p := make([]byte, 4096)
+	for read := 0; read < maxCacheDrain && !body.eof; {
+		n, err := body.Read(p)
+		read += n
+		if len(bytes.TrimSpace(p[:n])) > 0 {
+			return false
+		}
+		if err != nil && err != io.EOF {
+			return false
+		}
+	}
+	return body.eof
+}
+
+// openTelemetry returns the body of the telemetry at URL for streaming, the caller must close it.
+// When the clients Cache holds telemetry, the body is served from it, or copied into it while
+// it is read. Callers discard the body when decoding fails, so broken telemetry isn't cached.
+func (client Client) openTelemetry(ctx context.Context, URL string) (*telemetryBody, error) {
+	ttl := client.cacheTTLs.Telemetry
+	if client.cache == nil || ttl == 0 {
+		r, err := client.openPage(ctx, URL)
+		if err != nil {
+			return nil, err
+		}
+		return &telemetryBody{ReadCloser: r.Body}, nil
+	}
+
+	now := time.Now()
+	if cached, ok := client.openCacheEntry(URL, now); ok {
+		return &telemetryBody{ReadCloser: cached, cache: client.cache, key: URL}, nil
+	}
+
+	r, err := client.openPage(ctx, URL)
+	if err != nil {
+		return nil, err
+	}
+	body := &telemetryBody{ReadCloser: r.Body}
+
+	entry := cacheEntry{
+		URL:          URL,
+		ETag:         r.Header.Get("ETag"),
+		LastModified: r.Header.Get("Last-Modified"),
+	}
+	if ttl > 0 {
+		entry.Expires = now.Add(ttl).UnixNano()
+	}
+	header, _ := json.Marshal(entry)
+
+	// Caching is best effort, the download goes on without it.
+	w, err := client.createCacheEntry(URL)
+	if err != nil {
+		return body, nil
+	}
+	if _, err := w.Write(append(header, '\n')); err != nil {
+		w.Abort()
+		return body, nil
+	}
+	body.w = w
+
+	return body, nil
+}
+
+// openCacheEntry returns a reader of the page cached for URL, if it is cached and fresh.
+// Pages of a StreamingCache are streamed rather than read into memory.
+func (client Client) openCacheEntry(URL string, now time.Time) (io.ReadCloser, bool) {
+	streaming, ok := client.cache.(StreamingCache)
+	if !ok {
+		value, ok := client.cache.Get(URL)
+		if !ok {
+			return nil, false
+		}
+		entry, page, err := decodeCacheEntry(value)
+		if err != nil || entry.URL != URL || entry.expired(now) {
+			client.cache.Delete(URL)
+			return nil, false
+		}
+		return ioutil.NopCloser(bytes.NewReader(page)), true
+	}
+
+	value, ok := streaming.Open(URL)
+	if !ok {
+		return nil, false
+	}
+
+	buffered := bufio.NewReader(value)
+	line, err := buffered.ReadBytes('\n')
+	entry := cacheEntry{}
+	if err == nil {
+		err = json.Unmarshal(line, &entry)
+	}
+	if err != nil || entry.URL != URL || entry.expired(now) {
+		value.Close()
+		client.cache.Delete(URL)
+		return nil, false
+	}
+
+	return struct {
+		io.Reader
+		io.Closer
+	}{buffered, value}, true
+}
+
+// createCacheEntry returns a writer of a new cache value for URL.
+func (client Client) createCacheEntry(URL string) (CacheWriter, error) {
+	if streaming, ok := client.cache.(StreamingCache); ok {
+		return streaming.Create(URL)
+	}
+	return &bufferedCacheWriter{cache: client.cache, key: URL}, nil
+}
@@ -0,0 +1,187 @@
+package battleritego
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestTelemetryCache(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		if r.URL.Path == "/truncated" {
+			w.Write([]byte(testTelemetry[:len(testTelemetry)-1]))
+			return
+		}
+		w.Write([]byte(testTelemetry))
+	}))
+	defer server.Close()
+
+	caches := map[string]Cache{
+		"memory": NewMemoryCache(0, 1<<20),
+		"file":   NewFileCache(t.TempDir()),
+	}
+	for name, cache := range caches {
+		atomic.StoreInt32(&requests, 0)
+		client := NewClient("key", WithCache(cache))
+
+		for i := 0; i < 3; i++ {
+			telemetry, err := client.GetTelemetry(server.URL + "/telemetry")
+			if err != nil || len(telemetry.DeathEvents) != 1 {
+				t.Fatalf("%s: GetTelemetry() = %d deaths, %v", name, len(telemetry.DeathEvents), err)
+			}
+			err = client.StreamTelemetry(server.URL+"/telemetry", func(TelemetryEvent) error { return nil })
+			if err != nil {
+				t.Fatalf("%s: StreamTelemetry() error = %v", name, err)
+			}
+		}
+		if got := atomic.LoadInt32(&requests); got != 1 {
+			t.Errorf("%s: %d requests, want 1", name, got)
+		}
+
+		// Broken telemetry must not be cached.
+		for i := 0; i < 2; i++ {
+			if _, err := client.GetTelemetry(server.URL + "/truncated"); err == nil {
+				t.Fatalf("%s: GetTelemetry() of truncated telemetry returned no error", name)
+			}
+		}
+		if got := atomic.LoadInt32(&requests); got != 3 {
+			t.Errorf("%s: %d requests, want 3", name, got)
+		}
+	}
+}
+
+func TestMemoryCacheEviction(t *testing.T) {
+	cache := NewMemoryCache(2, 0)
+	cache.Set("a", []byte("1"))
+	cache.Set("b", []byte("2"))
+	cache.Get("a")
+	cache.Set("c", []byte("3"))
+	if _, ok := cache.Get("b"); ok {
+		t.Error("least recently used value was not evicted")
+	}
+	if _, ok := cache.Get("a"); !ok || cache.Len() != 2 {
+		t.Errorf("Len() = %d, want 2 with a kept", cache.Len())
+	}
+
+	cache = NewMemoryCache(0, 10)
+	cache.Set("a", []byte(strings.Repeat("a", 6)))
+	cache.Set("b", []byte(strings.Repeat("b", 4)))
+	cache.Set("c", []byte(strings.Repeat("c", 3)))
+	if _, ok := cache.Get("a"); ok || cache.Size() != 7 {
+		t.Errorf("Size() = %d, want 7 with a evicted", cache.Size())
+	}
+	cache.Set("d", []byte(strings.Repeat("d", 11)))
+	if _, ok := cache.Get("d"); ok || cache.Size() != 7 {
+		t.Errorf("value larger than the cache was stored, Size() = %d", cache.Size())
+	}
+}
+
+func TestCacheExpiryAndRevalidation(t *testing.T) {
+	var requests, notModified int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			atomic.AddInt32(&notModified, 1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		w.Write([]byte(`{"data":{"type":"player","id":"5","attributes":{"name":"Averse","stats":{}}}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("key", WithAPIURL(server.URL),
+		WithCache(NewMemoryCache(0, 0)),
+		WithCacheTTLs(CacheTTLs{Players: 50 * time.Millisecond}))
+
+	for i := 0; i < 3; i++ {
+		if player, err := client.GetPlayer(5); err != nil || player.Name != "Averse" {
+			t.Fatalf("GetPlayer() = %q, %v", player.Name, err)
+		}
+	}
+	if got := atomic.LoadInt32(&requests); got != 1 {
+		t.Fatalf("%d requests before expiry, want 1", got)
+	}
+
+	time.Sleep(60 * time.Millisecond)
+	if player, err := client.GetPlayer(5); err != nil || player.Name != "Averse" {
+		t.Fatalf("GetPlayer() after 304 = %q, %v", player.Name, err)
+	}
+	if atomic.LoadInt32(&requests) != 2 || atomic.LoadInt32(&notModified) != 1 {
+		t.Errorf("%d requests and %d not modified after expiry, want 2 and 1", requests, notModified)
+	}
+
+	// The revalidated response is fresh again.
+	client.GetPlayer(5)
+	if got := atomic.LoadInt32(&requests); got != 2 {
+		t.Errorf("%d requests after revalidation, want 2", got)
+	}
+
+	// Kinds with a TTL of zero are never cached.
+	client.GetStatus()
+	client.GetStatus()
+	if got := atomic.LoadInt32(&requests); got != 4 {
+		t.Errorf("%d requests, want 4", got)
+	}
+}
+
+func TestTelemetryCachePartialDecode(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.Write([]byte(testTelemetry[:len(testTelemetry)-1] + `,{garbage`))
+	}))
+	defer server.Close()
+
+	stop := errors.New("stop")
+	client := NewClient("key", WithCache(NewMemoryCache(0, 1<<20)))
+	for i := 0; i < 2; i++ {
+		err := client.StreamTelemetry(server.URL, func(TelemetryEvent) error { return stop })
+		if err != stop {
+			t.Fatalf("StreamTelemetry() error = %v, want %v", err, stop)
+		}
+	}
+	// The body was never decoded past the first event, so it must not be cached.
+	if got := atomic.LoadInt32(&requests); got != 2 {
+		t.Errorf("%d requests, want 2", got)
+	}
+}
+
+func TestTelemetryCacheCorruptEntry(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.Write([]byte(testTelemetry))
+	}))
+	defer server.Close()
+
+	cache := NewMemoryCache(0, 1<<20)
+	client := NewClient("key", WithCache(cache))
+	if _, err := client.GetTelemetry(server.URL); err != nil {
+		t.Fatal(err)
+	}
+	value, ok := cache.Get(server.URL)
+	if !ok {
+		t.Fatal("telemetry was not cached")
+	}
+	cache.Set(server.URL, value[:len(value)-1])
+
+	if _, err := client.GetTelemetry(server.URL); err == nil {
+		t.Fatal("GetTelemetry() of corrupt cached telemetry returned no error")
+	}
+	if _, ok := cache.Get(server.URL); ok {
+		t.Error("corrupt cached telemetry was not deleted")
+	}
+	if _, err := client.GetTelemetry(server.URL); err != nil {
+		t.Fatal(err)
+	}
+	if got := atomic.LoadInt32(&requests); got != 2 {
+		t.Errorf("%d requests, want 2", got)
+	}
+}
@@ -2,119 +2,327 @@
 package battleritego
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
 	"time"
 )
 
+// APIURL is the root of the Gamelocker API that shard and status URLs are built from.
+const APIURL = "https://api.developer.battlerite.com/"
+
+// DefaultShard is the shard used by a Client unless WithShard is passed to NewClient.
+const DefaultShard = "global"
+
 // BaseURL for the Gamelocker API using their current DC01 Datacenter.
 // See: https://battlerite-docs.readthedocs.io/en/latest/datacenters/datacenters.html
-const BaseURL = "https://api.developer.battlerite.com/shards/global/"
+const BaseURL = APIURL + "shards/" + DefaultShard + "/"
 
 // http request client with a timeout of 10 seconds.
 var request = &http.Client{Timeout: 10 * time.Second}
 
-// Response represents a response of a single piece of data from the http client request.
-type Response struct {
-	Data     interface{} `json:"data,omitempty"`
-	Errors   interface{} `json:"errors,omitempty"`
-	Links    interface{} `json:"links,omitempty"`
-	Included interface{} `json:"included,omitempty"`
-	Meta     interface{} `json:"meta,omitempty"`
-}
-
 // Client stores an API key.
+// A Client literal such as Client{APIKey: key} uses the default http client and BaseURL,
+// without rate limit tracking, retries or caching. Use NewClient to configure them.
+// As Client has unexported fields, the unkeyed form Client{key} no longer compiles.
 type Client struct {
 	APIKey string
+
+	httpClient  *http.Client
+	apiURL      string
+	shard       string
+	userAgent   string
+	rateLimiter *rateLimiter
+	retryPolicy RetryPolicy
+	cache       Cache
+	cacheTTLs   CacheTTLs
+}
+
+// NewClient returns a Client using the API key configured by the passed in options.
+// See options.go for the available options.
+func NewClient(apiKey string, options ...Option) *Client {
+	client := &Client{
+		APIKey:      apiKey,
+		httpClient:  &http.Client{Timeout: request.Timeout},
+		apiURL:      APIURL,
+		shard:       DefaultShard,
+		rateLimiter: &rateLimiter{},
+		cacheTTLs:   DefaultCacheTTLs,
+	}
+
+	for _, option := range options {
+		option(client)
+	}
+
+	return client
+}
+
+// http returns the http client used for requests.
+func (client Client) http() *http.Client {
+	if client.httpClient == nil {
+		return request
+	}
+	return client.httpClient
+}
+
+// rootURL returns the root of the API with a trailing slash.
+func (client Client) rootURL() string {
+	if client.apiURL == "" {
+		return APIURL
+	}
+	return client.apiURL
+}
+
+// baseURL returns the URL of the clients shard with a trailing slash.
+func (client Client) baseURL() string {
+	shard := client.shard
+	if shard == "" {
+		shard = DefaultShard
+	}
+	return client.rootURL() + "shards/" + shard + "/"
 }
 
 // getPageBytes retrieves the bites slice of a page.
-func (client Client) getPageBytes(URL string) ([]byte, error) {
-	req, _ := http.NewRequest("GET", URL, nil)
+// The page is served from and stored in the clients Cache, if it has one, see cache.go.
+func (client Client) getPageBytes(ctx context.Context, URL string) ([]byte, http.Header, error) {
+	return client.cachedPageBytes(ctx, URL, client.pageTTL(URL))
+}
+
+// fetchPageBytes requests a page with the extra headers in header and reads it.
+// The response is returned with its body closed, its status is http.StatusNotModified
+// if header held conditions that the page still matches.
+// Failed requests are retried according to the clients RetryPolicy.
+// The request is canceled if ctx is done before the page has been read.
+func (client Client) fetchPageBytes(ctx context.Context, URL string, header http.Header) ([]byte, *http.Response, error) {
+	var page []byte
+
+	r, err := client.doWithRetries(ctx, func() (*http.Response, error) {
+		r, err := client.sendRequest(ctx, URL, header)
+		if err != nil {
+			return r, err
+		}
+		defer r.Body.Close()
+
+		page, err = ioutil.ReadAll(r.Body)
+		if err != nil {
+			return nil, err
+		}
+		return r, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return page, r, nil
+}
+
+// openPage returns the successful response for a page with its body left open for
+// streaming, the caller must close it.
+// Failed requests are retried according to the clients RetryPolicy.
+func (client Client) openPage(ctx context.Context, URL string) (*http.Response, error) {
+	return client.doWithRetries(ctx, func() (*http.Response, error) {
+		return client.sendRequest(ctx, URL, nil)
+	})
+}
+
+// doWithRetries calls send until it succeeds or the clients RetryPolicy gives up,
+// returning the last error. If ctx ends while waiting to retry, the error wraps both the
+// context error and the error of the last attempt.
+func (client Client) doWithRetries(ctx context.Context, send func() (*http.Response, error)) (*http.Response, error) {
+	for attempt := 1; ; attempt++ {
+		r, err := send()
+		if err == nil {
+			return r, nil
+		}
+		if !client.retryPolicy.retryable(attempt, r, err) {
+			return nil, err
+		}
+
+		if sleepErr := sleepContext(ctx, client.retryPolicy.backoff(attempt, r)); sleepErr != nil {
+			return nil, fmt.Errorf("%w, last attempt failed: %w", sleepErr, err)
+		}
+	}
+}
+
+// sendRequest sends a single request for a page with the extra headers in header.
+// A successful or not modified response is returned with its body open. An error status
+// is returned as an APIError along with the response, which has its body read and closed.
+// The response is nil if none was received.
+func (client Client) sendRequest(ctx context.Context, URL string, header http.Header) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", URL, nil)
+	if err != nil {
+		return nil, err
+	}
+	for key, values := range header {
+		req.Header[key] = values
+	}
 	req.Header.Set("Authorization", client.APIKey)
 	req.Header.Set("Accept", "application/vnd.api+json")
+	if client.userAgent != "" {
+		req.Header.Set("User-Agent", client.userAgent)
+	}
+
+	// Only API requests count against the rate limit, not telemetry downloaded from the CDN.
+	limited := client.rateLimiter != nil && strings.HasPrefix(URL, client.rootURL())
+	if limited {
+		if err := client.rateLimiter.acquire(ctx); err != nil {
+			return nil, err
+		}
+	}
 
-	r, err := request.Do(req)
+	r, err := client.http().Do(req)
 	if err != nil {
 		return nil, err
 	}
 
-	if len(r.Header["X-Ratelimit-Remaining"]) > 0 && r.Header["X-Ratelimit-Remaining"][0] == "0" {
-		return nil, errors.New("Request rate limit hit 0, wait for more requests; " +
-			"Learn more: https://battlerite-docs.readthedocs.io/en/master/ratelimits/ratelimits.html ")
+	if limited {
+		client.rateLimiter.update(r.StatusCode, r.Header)
 	}
 
-	defer r.Body.Close()
+	if (r.StatusCode < 200 || r.StatusCode > 299) && r.StatusCode != http.StatusNotModified {
+		defer r.Body.Close()
 
-	page, err := ioutil.ReadAll(r.Body)
-	if err != nil {
-		return nil, err
+		page, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			return nil, err
+		}
+		return r, newAPIError(URL, r.StatusCode, page)
 	}
 
-	return page, nil
+	return r, nil
 }
 
 // getData returns data from the request URL.
-func (client Client) getData(URL string) (Response, error) {
-	page, err := client.getPageBytes(URL)
+func (client Client) getData(ctx context.Context, URL string) (Response, error) {
+	res, _, err := client.getDocument(ctx, URL)
+	return res, err
+}
+
+// getDocument returns data from the request URL along with information about the response.
+func (client Client) getDocument(ctx context.Context, URL string) (Response, ResponseInfo, error) {
+	page, header, err := client.getPageBytes(ctx, URL)
 	if err != nil {
-		return Response{}, err
+		return Response{}, ResponseInfo{}, err
 	}
 
 	res := Response{}
 	jsonErr := json.Unmarshal(page, &res)
 	if jsonErr != nil {
-		return Response{}, jsonErr
+		return Response{}, ResponseInfo{}, jsonErr
 	}
-	if res.Errors != nil {
-		return res, fmt.Errorf("Something went wrong with the api request, Errors: %v", res.Errors)
+
+	info := newResponseInfo(URL, res, header)
+	if len(res.Errors) > 0 {
+		return res, info, &APIError{URL: URL, Errors: res.Errors}
 	}
 
-	return res, nil
+	return res, info, nil
 }
 
 // GetStatus receives the Status of the Gamelocker battlerite API.
 func (client Client) GetStatus() (Status, error) {
-	URL := "https://api.developer.battlerite.com/status"
+	return client.GetStatusContext(context.Background())
+}
+
+// GetStatusContext is like GetStatus but uses ctx for the request.
+func (client Client) GetStatusContext(ctx context.Context) (Status, error) {
+	URL := client.rootURL() + "status"
 
-	res, err := client.getData(URL)
+	res, err := client.getData(ctx, URL)
 
 	if err != nil {
 		return Status{}, err
 	}
 
-	attributes := res.Data.(map[string]interface{})["attributes"].(map[string]interface{})
+	resource, err := res.Resource()
+	if err != nil {
+		return Status{}, err
+	}
+
+	attributes := statusAttributes{}
+	if err := resource.decodeAttributes(&attributes); err != nil {
+		return Status{}, err
+	}
+
 	return Status{
-		res.Data.(map[string]interface{})["type"].(string),
-		res.Data.(map[string]interface{})["id"].(string),
-		attributes["releasedAt"].(string),
-		attributes["version"].(string),
+		resource.Type,
+		resource.ID,
+		attributes.ReleasedAt,
+		attributes.Version,
 	}, nil
 }
 
 // GetPlayer receives a single Player using the players battlerite ID.
 func (client Client) GetPlayer(id int) (Player, error) {
-	URL := fmt.Sprintf("%splayers/%s", BaseURL, strconv.Itoa(id))
+	return client.GetPlayerContext(context.Background(), id)
+}
+
+// GetPlayerContext is like GetPlayer but uses ctx for the request.
+func (client Client) GetPlayerContext(ctx context.Context, id int) (Player, error) {
+	URL := fmt.Sprintf("%splayers/%s", client.baseURL(), strconv.Itoa(id))
+
+	res, err := client.getData(ctx, URL)
+	if err != nil {
+		return Player{}, err
+	}
 
-	res, err := client.getData(URL)
+	resource, err := res.Resource()
 	if err != nil {
 		return Player{}, err
 	}
 
-	return SinglePlayerFromData(res.Data.(map[string]interface{})), nil
+	return SinglePlayerFromResource(resource)
 }
 
 // GetPlayersFiltered receives a slice of players using the passed in PlayerFilter.
 func (client Client) GetPlayersFiltered(filter PlayerFilter) ([]Player, error) {
-	URL := fmt.Sprintf("%splayers?", BaseURL)
+	return client.GetPlayersFilteredContext(context.Background(), filter)
+}
+
+// GetPlayersFilteredContext is like GetPlayersFiltered but uses ctx for the request.
+func (client Client) GetPlayersFilteredContext(ctx context.Context, filter PlayerFilter) ([]Player, error) {
+	page, err := client.GetPlayersPageContext(ctx, filter)
+	return page.Players, err
+}
+
+// GetPlayersPage is like GetPlayersFiltered but also returns information about the response.
+// See PlayersPage in response.go.
+func (client Client) GetPlayersPage(filter PlayerFilter) (PlayersPage, error) {
+	return client.GetPlayersPageContext(context.Background(), filter)
+}
+
+// GetPlayersPageContext is like GetPlayersPage but uses ctx for the request.
+func (client Client) GetPlayersPageContext(ctx context.Context, filter PlayerFilter) (PlayersPage, error) {
+	page := PlayersPage{Players: []Player{}}
+
+	res, info, err := client.getDocument(ctx, client.playersURL(filter))
+	page.ResponseInfo = info
+	if err != nil {
+		return page, err
+	}
+
+	resources, err := res.Resources()
+	if err != nil {
+		return page, err
+	}
+
+	page.Players, err = MultiPlayersFromResources(resources)
+	return page, err
+}
+
+// playersURL returns the URL of the players collection filtered by filter.
+func (client Client) playersURL(filter PlayerFilter) string {
+	URL := fmt.Sprintf("%splayers?", client.baseURL())
 
 	if filter.Names != nil {
-		URL += fmt.Sprintf("&filter[playerNames]=%s", strings.Join(filter.Names, ","))
+		URL += fmt.Sprintf("&filter[playerNames]=%s", joinQuery(filter.Names))
 	}
 	if filter.UserIDs != nil {
 		// Convert []int -> []string
@@ -135,23 +343,59 @@ func (client Client) GetPlayersFiltered(filter PlayerFilter) ([]Player, error) {
 		URL += fmt.Sprintf("&filter[steamIds]=%s", strings.Join(strSteamIDs, ","))
 	}
 
-	res, err := client.getData(URL)
-	if err != nil {
-		return []Player{}, err
-	}
-
-	return MultiPlayersFromData(res.Data.([]interface{}))
+	return URL
 }
 
 // GetTeamsFiltered returns a slice of teams using the TeamFilter.
 // See TeamFilter in team.go.
 func (client Client) GetTeamsFiltered(filter TeamFilter) ([]Team, error) {
+	return client.GetTeamsFilteredContext(context.Background(), filter)
+}
+
+// GetTeamsFilteredContext is like GetTeamsFiltered but uses ctx for the request.
+func (client Client) GetTeamsFilteredContext(ctx context.Context, filter TeamFilter) ([]Team, error) {
+	page, err := client.GetTeamsPageContext(ctx, filter)
+	return page.Teams, err
+}
+
+// GetTeamsPage is like GetTeamsFiltered but also returns information about the response.
+// See TeamsPage in response.go.
+func (client Client) GetTeamsPage(filter TeamFilter) (TeamsPage, error) {
+	return client.GetTeamsPageContext(context.Background(), filter)
+}
+
+// GetTeamsPageContext is like GetTeamsPage but uses ctx for the request.
+func (client Client) GetTeamsPageContext(ctx context.Context, filter TeamFilter) (TeamsPage, error) {
+	page := TeamsPage{Teams: []Team{}}
+
+	URL, err := client.teamsURL(filter)
+	if err != nil {
+		return page, err
+	}
+
+	res, info, err := client.getDocument(ctx, URL)
+	page.ResponseInfo = info
+	if err != nil {
+		return page, err
+	}
+
+	resources, err := res.Resources()
+	if err != nil {
+		return page, err
+	}
+
+	page.Teams, err = MultiTeamsFromResources(resources)
+	return page, err
+}
+
+// teamsURL returns the URL of the teams collection filtered by filter.
+func (client Client) teamsURL(filter TeamFilter) (string, error) {
 	// Ensure TeamFilter contains Season and PlayerIDs
 	if filter.Season == 0 {
-		return []Team{}, errors.New("TeamFilter must contain a Season")
+		return "", errors.New("TeamFilter must contain a Season")
 	}
 	if filter.PlayerIDs == nil {
-		return []Team{}, errors.New("TeamFilter must contain PlayerIDs")
+		return "", errors.New("TeamFilter must contain PlayerIDs")
 	}
 
 	season := fmt.Sprintf("&filter[season]=%s", strconv.Itoa(filter.Season))
@@ -164,31 +408,77 @@ func (client Client) GetTeamsFiltered(filter TeamFilter) ([]Team, error) {
 
 	playerIDs := fmt.Sprintf("&filter[playerIds]=%s", strings.Join(strPlayerIDs, ","))
 
-	URL := fmt.Sprintf("%steams?%s%s", BaseURL, season, playerIDs)
-
-	res, err := client.getData(URL)
-	if err != nil {
-		return []Team{}, err
-	}
+	URL := fmt.Sprintf("%steams?%s%s", client.baseURL(), season, playerIDs)
 
-	return MultiTeamsFromData(res.Data.([]interface{}))
+	return URL, nil
 }
 
 // GetMatch returns a single match filtered by ID.
 func (client Client) GetMatch(id string) (Match, error) {
-	URL := fmt.Sprintf("%smatches/%s", BaseURL, id)
-	res, err := client.getData(URL)
+	return client.GetMatchContext(context.Background(), id)
+}
+
+// GetMatchContext is like GetMatch but uses ctx for the request.
+func (client Client) GetMatchContext(ctx context.Context, id string) (Match, error) {
+	URL := fmt.Sprintf("%smatches/%s", client.baseURL(), id)
+	res, err := client.getData(ctx, URL)
 	if err != nil {
-		return Match{}, nil
+		return Match{}, err
 	}
 
-	return SingleMatchFromResponse(res), nil
+	return SingleMatchFromResponse(res)
 }
 
 // GetMatchesFiltered returns a slice of matches filtered by MatchFilter.
 // See MatchFilter in match.go
 func (client Client) GetMatchesFiltered(filter MatchFilter) ([]Match, error) {
-	URL := fmt.Sprintf("%smatches?", BaseURL)
+	return client.GetMatchesFilteredContext(context.Background(), filter)
+}
+
+// GetMatchesFilteredContext is like GetMatchesFiltered but uses ctx for the request.
+func (client Client) GetMatchesFilteredContext(ctx context.Context, filter MatchFilter) ([]Match, error) {
+	page, err := client.GetMatchesPageContext(ctx, filter)
+	return page.Matches, err
+}
+
+// GetMatchesPage is like GetMatchesFiltered but also returns information about the response,
+// such as the links to the next and previous pages.
+// See MatchesPage in response.go.
+func (client Client) GetMatchesPage(filter MatchFilter) (MatchesPage, error) {
+	return client.GetMatchesPageContext(context.Background(), filter)
+}
+
+// GetMatchesPageContext is like GetMatchesPage but uses ctx for the request.
+func (client Client) GetMatchesPageContext(ctx context.Context, filter MatchFilter) (MatchesPage, error) {
+	URL, err := client.matchesURL(filter)
+	if err != nil {
+		return MatchesPage{Matches: []Match{}}, err
+	}
+
+	return client.getMatchesPage(ctx, URL)
+}
+
+// getMatchesPage returns the page of matches at URL.
+func (client Client) getMatchesPage(ctx context.Context, URL string) (MatchesPage, error) {
+	page := MatchesPage{Matches: []Match{}}
+
+	res, info, err := client.getDocument(ctx, URL)
+	page.ResponseInfo = info
+	if err != nil {
+		return page, err
+	}
+
+	page.Matches, err = MultiMatchesFromResponse(res)
+	return page, err
+}
+
+// matchesURL returns the URL of the matches collection filtered by filter.
+func (client Client) matchesURL(filter MatchFilter) (string, error) {
+	if err := filter.Validate(); err != nil {
+		return "", err
+	}
+
+	URL := fmt.Sprintf("%smatches?", client.baseURL())
 
 	if filter.PageOffset != 0 {
 		URL += "&page[offset]=" + strconv.Itoa(filter.PageOffset)
@@ -199,25 +489,41 @@ func (client Client) GetMatchesFiltered(filter MatchFilter) ([]Match, error) {
 	if filter.Sort != "" {
 		URL += fmt.Sprintf("&sort=%s", filter.Sort)
 	}
-	if filter.CreatedAtStart != "" {
-		URL += fmt.Sprintf("&filter[createdAt-start]=%s", filter.CreatedAtStart)
+	if !filter.CreatedAtStart.IsZero() {
+		URL += "&filter[createdAt-start]=" + filter.CreatedAtStart.UTC().Format(time.RFC3339)
 	}
-	if filter.CreatedAtEnd != "" {
-		URL += fmt.Sprintf("&filter[createdAt-end]=%s", filter.CreatedAtEnd)
+	if !filter.CreatedAtEnd.IsZero() {
+		URL += "&filter[createdAt-end]=" + filter.CreatedAtEnd.UTC().Format(time.RFC3339)
 	}
 	if filter.PlayerIDs != nil {
-		URL += "&filter[playerIds]=" + strings.Join(filter.PlayerIDs, ",")
+		URL += "&filter[playerIds]=" + joinQuery(filter.PlayerIDs)
 	}
 	if filter.PatchVersion != nil {
-		URL += "&filter[patchVersion]=" + strings.Join(filter.PatchVersion, ",")
+		URL += "&filter[patchVersion]=" + joinQuery(filter.PatchVersion)
 	}
-
-	res, err := client.getData(URL)
-	if err != nil {
-		return []Match{}, err
+	if filter.GameModes != nil {
+		URL += "&filter[gameMode]=" + joinQuery(filter.GameModes)
 	}
+	if filter.RankingTypes != nil {
+		URL += "&filter[rankingType]=" + joinQuery(filter.RankingTypes)
+	}
+	if filter.ServerTypes != nil {
+		URL += "&filter[serverType]=" + joinQuery(filter.ServerTypes)
+	}
+	if filter.TeamNames != nil {
+		URL += "&filter[teamNames]=" + joinQuery(filter.TeamNames)
+	}
+
+	return URL, nil
+}
 
-	return MultiMatchesFromResponse(res), nil
+// joinQuery escapes each value for use in a query string and joins them with commas.
+func joinQuery(values []string) string {
+	escaped := []string{}
+	for _, value := range values {
+		escaped = append(escaped, url.QueryEscape(value))
+	}
+	return strings.Join(escaped, ",")
 }
 
 // GetTelemetry returns telemetry data relating to match.
@@ -225,70 +531,79 @@ func (client Client) GetMatchesFiltered(filter MatchFilter) ([]Match, error) {
 // either GetMactch or GetMatchesFiltered.
 // See match.go for more information about Matches
 func (client Client) GetTelemetry(URL string) (Telemetry, error) {
-	page, err := client.getPageBytes(URL)
+	return client.GetTelemetryContext(context.Background(), URL)
+}
+
+// GetTelemetryContext is like GetTelemetry but uses ctx for the request.
+func (client Client) GetTelemetryContext(ctx context.Context, URL string) (Telemetry, error) {
+	body, err := client.openTelemetry(ctx, URL)
 	if err != nil {
 		return Telemetry{}, err
 	}
+	defer body.Close()
 
-	var data []interface{}
-	jsonErr := json.Unmarshal(page, &data)
-	if jsonErr != nil {
-		return Telemetry{}, jsonErr
-	}
-
-	matchStart := MatchStart{}
-	roundEventList := []RoundEvent{}
-	userRoundSpellList := []UserRoundSpell{}
-	deathEventList := []DeathEvent{}
-	matchReservedUserList := []MatchReservedUser{}
-	queueEventList := []QueueEvent{}
-	teamUpdateEventList := []TeamUpdateEvent{}
-	serverShutdown := ServerShutdown{}
-	roundFinishedEventList := []RoundFinishedEvent{}
-	matchFinishedEvent := MatchFinishedEvent{}
-
-	for _, event := range data {
-		switch event.(map[string]interface{})["type"] {
-		case "Structures.MatchStart":
-			matchStart = MatchStartFromData(event.(map[string]interface{}))
-		case "Structures.RoundEvent":
-			roundEvent := RoundEventFromData(event.(map[string]interface{}))
-			roundEventList = append(roundEventList, roundEvent)
-		case "Structures.UserRoundSpell":
-			userRoundSpell := UserRoundSpellFromData(event.(map[string]interface{}))
-			userRoundSpellList = append(userRoundSpellList, userRoundSpell)
-		case "Structures.DeathEvent":
-			deathEvent := DeathEventFromData(event.(map[string]interface{}))
-			deathEventList = append(deathEventList, deathEvent)
-		case "Structures.MatchReservedUser":
-			matchReservedUser := MatchReservedUserFromData(event.(map[string]interface{}))
-			matchReservedUserList = append(matchReservedUserList, matchReservedUser)
-		case "com.stunlock.service.matchmaking.avro.QueueEvent":
-			queueEvent := QueueEventFromData(event.(map[string]interface{}))
-			queueEventList = append(queueEventList, queueEvent)
-		case "com.stunlock.battlerite.team.TeamUpdateEvent":
-			teamUpdateEvent := TeamUpdateEventFromData(event.(map[string]interface{}))
-			teamUpdateEventList = append(teamUpdateEventList, teamUpdateEvent)
-		case "Structures.ServerShutdown":
-			serverShutdown = ServerShutdownFromData(event.(map[string]interface{}))
-		case "Structures.RoundFinishedEvent":
-			roundFinishedEvent := RoundFinishedEventFromData(event.(map[string]interface{}))
-			roundFinishedEventList = append(roundFinishedEventList, roundFinishedEvent)
-		case "Structures.MatchFinishedEvent":
-			matchFinishedEvent = MatchFinishedEventFromData(event.(map[string]interface{}))
+	telemetry, err := DecodeTelemetry(body)
+	if err != nil {
+		body.discard()
+		return telemetry, err
+	}
+	body.finish()
+	return telemetry, nil
+}
+
+// StreamTelemetry decodes the telemetry data at URL one event at a time, calling fn for
+// each of them as soon as it is read, without holding the whole Telemetry in memory.
+// Decoding stops at the first error returned by fn, which StreamTelemetry returns.
+// See TelemetryDecoder in telemetry_decoder.go.
+func (client Client) StreamTelemetry(URL string, fn func(TelemetryEvent) error) error {
+	return client.StreamTelemetryContext(context.Background(), URL, fn)
+}
+
+// StreamTelemetryContext is like StreamTelemetry but uses ctx for the request.
+func (client Client) StreamTelemetryContext(ctx context.Context, URL string, fn func(TelemetryEvent) error) error {
+	body, err := client.openTelemetry(ctx, URL)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	decoder := NewTelemetryDecoder(body)
+	for {
+		event, err := decoder.Next()
+		if err == io.EOF {
+			body.finish()
+			return nil
+		}
+		if err != nil {
+			body.discard()
+			return err
+		}
+
+		if err := fn(event); err != nil {
+			return err
 		}
 	}
+}
 
-	return Telemetry{
-		MatchStart:          matchStart,
-		RoundEvents:         roundEventList,
-		UserRoundSpells:     userRoundSpellList,
-		DeathEvents:         deathEventList,
-		MatchReservedUsers:  matchReservedUserList,
-		QueueEvents:         queueEventList,
-		TeamUpdateEvents:    teamUpdateEventList,
-		ServerShutdown:      serverShutdown,
-		RoundFinishedEvents: roundFinishedEventList,
-		MatchFinishedEvent:  matchFinishedEvent,
-	}, nil
+// VisitTelemetry streams the telemetry data at URL like StreamTelemetry, dispatching each
+// event to handlers in cursor order. See TelemetryHandlers in telemetry_handlers.go.
+func (client Client) VisitTelemetry(URL string, handlers TelemetryHandlers) error {
+	return client.VisitTelemetryContext(context.Background(), URL, handlers)
+}
+
+// VisitTelemetryContext is like VisitTelemetry but uses ctx for the request.
+func (client Client) VisitTelemetryContext(ctx context.Context, URL string, handlers TelemetryHandlers) error {
+	body, err := client.openTelemetry(ctx, URL)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	err = NewTelemetryDecoder(body).Visit(handlers)
+	if err != nil {
+		body.discard()
+		return err
+	}
+	body.finish()
+	return nil
 }
@@ -0,0 +1,119 @@
+package battleritego
+
+import "fmt"
+
+// fieldReader reads typed fields out of decoded JSON data without panicking.
+// The first missing or mistyped field is recorded and returned by err, every read after
+// it returns a zero value, so a whole struct can be filled before checking for an error.
+type fieldReader struct {
+	name string
+	path string
+	data map[string]interface{}
+	fail *error
+}
+
+// newFieldReader returns a fieldReader over data, name is used to describe errors.
+func newFieldReader(name string, data map[string]interface{}) fieldReader {
+	return fieldReader{
+		name: name,
+		data: data,
+		fail: new(error),
+	}
+}
+
+// err returns the first error recorded by the reader or any of its child readers.
+func (f fieldReader) err() error {
+	return *f.fail
+}
+
+// setErr records an error for key unless one has already been recorded.
+func (f fieldReader) setErr(key string, kind string) {
+	if *f.fail == nil {
+		*f.fail = fmt.Errorf("battleritego: decoding %s: field %s%s is missing or not %s", f.name, f.path, key, kind)
+	}
+}
+
+// value returns the raw value of key, which is nil if it is missing.
+func (f fieldReader) value(key string) interface{} {
+	return f.data[key]
+}
+
+// object returns a child reader over the JSON object at key.
+func (f fieldReader) object(key string) fieldReader {
+	obj, ok := f.data[key].(map[string]interface{})
+	if !ok {
+		f.setErr(key, "an object")
+		obj = map[string]interface{}{}
+	}
+
+	return fieldReader{
+		name: f.name,
+		path: f.path + key + ".",
+		data: obj,
+		fail: f.fail,
+	}
+}
+
+// slice returns the JSON array at key.
+func (f fieldReader) slice(key string) []interface{} {
+	arr, ok := f.data[key].([]interface{})
+	if !ok {
+		f.setErr(key, "an array")
+	}
+	return arr
+}
+
+// string returns the JSON string at key.
+func (f fieldReader) string(key string) string {
+	str, ok := f.data[key].(string)
+	if !ok {
+		f.setErr(key, "a string")
+	}
+	return str
+}
+
+// float returns the JSON number at key.
+func (f fieldReader) float(key string) float64 {
+	num, ok := f.data[key].(float64)
+	if !ok {
+		f.setErr(key, "a number")
+	}
+	return num
+}
+
+// int returns the JSON number at key as an int.
+func (f fieldReader) int(key string) int {
+	return int(f.float(key))
+}
+
+// bool returns the JSON boolean at key.
+func (f fieldReader) bool(key string) bool {
+	b, ok := f.data[key].(bool)
+	if !ok {
+		f.setErr(key, "a boolean")
+	}
+	return b
+}
+
+// toObjects returns data as a slice of JSON objects, a nil data is an empty slice.
+func toObjects(name string, data interface{}) ([]map[string]interface{}, error) {
+	objs := []map[string]interface{}{}
+	if data == nil {
+		return objs, nil
+	}
+
+	arr, ok := data.([]interface{})
+	if !ok {
+		return objs, fmt.Errorf("battleritego: decoding %s: data is not an array", name)
+	}
+
+	for i, v := range arr {
+		obj, ok := v.(map[string]interface{})
+		if !ok {
+			return objs, fmt.Errorf("battleritego: decoding %s: data[%d] is not an object", name, i)
+		}
+		objs = append(objs, obj)
+	}
+
+	return objs, nil
+}
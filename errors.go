@@ -0,0 +1,108 @@
+package battleritego
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// Sentinel errors returned by Client requests, an APIError matches the one for its status
+// so they can be checked with errors.Is.
+var (
+	ErrNotFound     = errors.New("battleritego: resource not found")
+	ErrUnauthorized = errors.New("battleritego: unauthorized, check the API key")
+	ErrRateLimited  = errors.New("battleritego: request rate limit reached")
+)
+
+// ErrInvalidFilter is returned when a filter is rejected before a request is sent.
+var ErrInvalidFilter = errors.New("battleritego: invalid filter")
+
+// ErrNoTelemetry is returned when telemetry is requested for a Match without a telemetry asset.
+var ErrNoTelemetry = errors.New("battleritego: match has no telemetry asset")
+
+// ErrorObject contains information about a single error returned by the API.
+// See https://jsonapi.org/format/#error-objects
+type ErrorObject struct {
+	ID     string `json:"id,omitempty"`
+	Status string `json:"status,omitempty"`
+	Code   string `json:"code,omitempty"`
+	Title  string `json:"title,omitempty"`
+	Detail string `json:"detail,omitempty"`
+}
+
+// APIError is returned when the API responds with an error status or error objects.
+// Use errors.As to inspect it, or errors.Is with ErrNotFound, ErrUnauthorized and ErrRateLimited.
+type APIError struct {
+	StatusCode int
+	URL        string
+	Errors     []ErrorObject
+}
+
+// newAPIError returns an APIError with any error objects found in the response body.
+func newAPIError(URL string, statusCode int, body []byte) *APIError {
+	res := struct {
+		Errors []ErrorObject `json:"errors"`
+	}{}
+	// A body that is not a JSON:API document still gives a useful status code.
+	_ = json.Unmarshal(body, &res)
+
+	return &APIError{
+		StatusCode: statusCode,
+		URL:        URL,
+		Errors:     res.Errors,
+	}
+}
+
+// status returns the HTTP status of the error, falling back to the status of the
+// first error object when the response itself did not have an error status.
+func (err *APIError) status() int {
+	if err.StatusCode >= 400 {
+		return err.StatusCode
+	}
+	for _, obj := range err.Errors {
+		if status, convErr := strconv.Atoi(obj.Status); convErr == nil {
+			return status
+		}
+	}
+	return err.StatusCode
+}
+
+func (err *APIError) Error() string {
+	msg := fmt.Sprintf("battleritego: api request to %s failed", err.URL)
+	if status := err.status(); status != 0 {
+		msg += fmt.Sprintf(" with status %d %s", status, http.StatusText(status))
+	}
+
+	details := []string{}
+	for _, obj := range err.Errors {
+		switch {
+		case obj.Title != "" && obj.Detail != "":
+			details = append(details, obj.Title+": "+obj.Detail)
+		case obj.Title != "":
+			details = append(details, obj.Title)
+		case obj.Detail != "":
+			details = append(details, obj.Detail)
+		}
+	}
+	if len(details) > 0 {
+		msg += "; " + strings.Join(details, "; ")
+	}
+
+	return msg
+}
+
+// Is reports whether target is the sentinel error matching the status of err.
+func (err *APIError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return err.status() == http.StatusNotFound
+	case ErrUnauthorized:
+		return err.status() == http.StatusUnauthorized
+	case ErrRateLimited:
+		return err.status() == http.StatusTooManyRequests
+	}
+	return false
+}
@@ -0,0 +1,203 @@
+package battleritego
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// Response represents a JSON:API document returned by the API.
+// Data is kept raw since it holds either a single resource or an array of them,
+// see Resource and Resources.
+// See https://jsonapi.org/format/#document-structure
+type Response struct {
+	Data     json.RawMessage `json:"data,omitempty"`
+	Errors   []ErrorObject   `json:"errors,omitempty"`
+	Links    Links           `json:"links,omitempty"`
+	Included []Resource      `json:"included,omitempty"`
+	Meta     Meta            `json:"meta,omitempty"`
+}
+
+// Links contains the links of a document, resource or relationship, such as "self" or "next".
+type Links map[string]string
+
+// Meta contains non-standard information about a document or resource.
+type Meta map[string]interface{}
+
+// Resource is a single JSON:API resource object such as a match, player or roster.
+// Attributes are kept raw and decoded into the type the resource is converted to.
+// See https://jsonapi.org/format/#document-resource-objects
+type Resource struct {
+	Type          string                  `json:"type"`
+	ID            string                  `json:"id"`
+	Attributes    json.RawMessage         `json:"attributes,omitempty"`
+	Relationships map[string]Relationship `json:"relationships,omitempty"`
+	Links         Links                   `json:"links,omitempty"`
+	Meta          Meta                    `json:"meta,omitempty"`
+}
+
+// ResourceIdentifier identifies a single Resource, usually one found in Response.Included.
+type ResourceIdentifier struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+}
+
+// Relationship links a Resource to other resources.
+// Data is kept raw since it holds null, a single identifier or an array of them,
+// see Identifiers.
+// See https://jsonapi.org/format/#document-resource-object-relationships
+type Relationship struct {
+	Data  json.RawMessage `json:"data,omitempty"`
+	Links Links           `json:"links,omitempty"`
+	Meta  Meta            `json:"meta,omitempty"`
+}
+
+// Resource returns the resource of a Response holding a single resource.
+// A Response without data or with null data holds no resource, the error matches ErrNotFound.
+func (res Response) Resource() (Resource, error) {
+	resource := Resource{}
+	data := bytes.TrimSpace(res.Data)
+	if len(data) == 0 || bytes.Equal(data, []byte("null")) {
+		return resource, fmt.Errorf("%w: response data is null", ErrNotFound)
+	}
+	if isJSONArray(data) {
+		return resource, errors.New("battleritego: decoding response: data is an array, not a single resource")
+	}
+	if err := json.Unmarshal(data, &resource); err != nil {
+		return resource, err
+	}
+	return resource, nil
+}
+
+// Resources returns the resources of a Response holding an array of resources.
+func (res Response) Resources() ([]Resource, error) {
+	resources := []Resource{}
+	if !isJSONArray(res.Data) {
+		return resources, errors.New("battleritego: decoding response: data is not an array of resources")
+	}
+	if err := json.Unmarshal(res.Data, &resources); err != nil {
+		return resources, err
+	}
+	return resources, nil
+}
+
+// Identifiers returns the identifiers of the related resources, which is empty for a null relationship.
+func (rel Relationship) Identifiers() ([]ResourceIdentifier, error) {
+	identifiers := []ResourceIdentifier{}
+	data := bytes.TrimSpace(rel.Data)
+
+	switch {
+	case len(data) == 0 || bytes.Equal(data, []byte("null")):
+		return identifiers, nil
+	case isJSONArray(data):
+		err := json.Unmarshal(data, &identifiers)
+		return identifiers, err
+	}
+
+	identifier := ResourceIdentifier{}
+	if err := json.Unmarshal(data, &identifier); err != nil {
+		return identifiers, err
+	}
+	return append(identifiers, identifier), nil
+}
+
+// Identifier returns the identifier of the resource itself.
+func (resource Resource) Identifier() ResourceIdentifier {
+	return ResourceIdentifier{Type: resource.Type, ID: resource.ID}
+}
+
+// related returns the included resources of the named relationship that are found in included.
+func (resource Resource) related(name string, included map[ResourceIdentifier]Resource) ([]Resource, error) {
+	related := []Resource{}
+
+	identifiers, err := resource.Relationships[name].Identifiers()
+	if err != nil {
+		return related, err
+	}
+
+	for _, identifier := range identifiers {
+		if incl, ok := included[identifier]; ok {
+			related = append(related, incl)
+		}
+	}
+
+	return related, nil
+}
+
+// decodeAttributes unmarshals the attributes of the resource into v.
+func (resource Resource) decodeAttributes(v interface{}) error {
+	if len(resource.Attributes) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(resource.Attributes, v); err != nil {
+		return fmt.Errorf("battleritego: decoding %s %s: %w", resource.Type, resource.ID, err)
+	}
+	return nil
+}
+
+// truncatedInt is an integer attribute, which the API sometimes sends with a fraction
+// such as 12.5. The fraction is dropped rather than failing the whole resource.
+type truncatedInt int
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (n *truncatedInt) UnmarshalJSON(data []byte) error {
+	var f float64
+	if err := json.Unmarshal(data, &f); err != nil {
+		return err
+	}
+	*n = truncatedInt(f)
+	return nil
+}
+
+// indexResources returns the resources keyed by their identifier.
+func indexResources(resources []Resource) map[ResourceIdentifier]Resource {
+	index := make(map[ResourceIdentifier]Resource, len(resources))
+	for _, resource := range resources {
+		index[resource.Identifier()] = resource
+	}
+	return index
+}
+
+// resourceFromData converts the decoded JSON of a resource back into a Resource.
+func resourceFromData(data map[string]interface{}) (Resource, error) {
+	resource := Resource{}
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return resource, err
+	}
+	err = json.Unmarshal(raw, &resource)
+	return resource, err
+}
+
+// resourcesFromData converts the decoded JSON of an array of resources back into Resources.
+func resourcesFromData(data []interface{}) ([]Resource, error) {
+	resources := []Resource{}
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return resources, err
+	}
+	err = json.Unmarshal(raw, &resources)
+	return resources, err
+}
+
+// isJSONArray reports whether data holds a JSON array.
+func isJSONArray(data json.RawMessage) bool {
+	data = bytes.TrimSpace(data)
+	return len(data) > 0 && data[0] == '['
+}
+
+// toGeneric converts v into the map[string]interface{} and []interface{} form that
+// encoding/json decodes into an interface{}, a nil or empty v is nil.
+func toGeneric(v interface{}) (interface{}, error) {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var generic interface{}
+	if err := json.Unmarshal(raw, &generic); err != nil {
+		return nil, err
+	}
+	return generic, nil
+}
@@ -1,15 +1,69 @@
 package battleritego
 
+import (
+	"fmt"
+	"time"
+)
+
+// MaxMatchPageLimit is the largest PageLimit the API accepts for a MatchFilter.
+const MaxMatchPageLimit = 5
+
+// MaxMatchWindow is the longest time the API accepts between CreatedAtStart and CreatedAtEnd.
+const MaxMatchWindow = 28 * 24 * time.Hour
+
+// MatchSort is the order matches are returned in.
+type MatchSort string
+
+// Orders for MatchFilter.Sort.
+const (
+	SortCreatedAt     MatchSort = "createdAt"
+	SortCreatedAtDesc MatchSort = "-createdAt"
+)
+
 // MatchFilter contains filter parameters for searching matches.
+// Fields left at their zero value are not sent, so the API defaults are used.
 // See https://battlerite-docs.readthedocs.io/en/master/matches/matches.html#get-a-collection-of-matches
 type MatchFilter struct {
 	PageOffset     int
 	PageLimit      int
-	Sort           string
-	CreatedAtStart string
-	CreatedAtEnd   string
+	Sort           MatchSort
+	CreatedAtStart time.Time
+	CreatedAtEnd   time.Time
 	PlayerIDs      []string
 	PatchVersion   []string
+	GameModes      []string
+	RankingTypes   []string
+	ServerTypes    []string
+	TeamNames      []string
+}
+
+// Validate returns an error matching ErrInvalidFilter if the API would reject the filter.
+func (filter MatchFilter) Validate() error {
+	if filter.PageOffset < 0 {
+		return fmt.Errorf("%w: MatchFilter PageOffset must not be negative", ErrInvalidFilter)
+	}
+	if filter.PageLimit < 0 || filter.PageLimit > MaxMatchPageLimit {
+		return fmt.Errorf("%w: MatchFilter PageLimit must be between 0 and %d", ErrInvalidFilter, MaxMatchPageLimit)
+	}
+
+	switch filter.Sort {
+	case "", SortCreatedAt, SortCreatedAtDesc:
+	default:
+		return fmt.Errorf("%w: MatchFilter Sort %q is not %q or %q", ErrInvalidFilter, filter.Sort, SortCreatedAt, SortCreatedAtDesc)
+	}
+
+	start, end := filter.CreatedAtStart, filter.CreatedAtEnd
+	if !start.IsZero() && end.IsZero() {
+		end = time.Now()
+	}
+	if !start.IsZero() && start.After(end) {
+		return fmt.Errorf("%w: MatchFilter CreatedAtStart must be before CreatedAtEnd", ErrInvalidFilter)
+	}
+	if !start.IsZero() && end.Sub(start) > MaxMatchWindow {
+		return fmt.Errorf("%w: MatchFilter CreatedAtStart and CreatedAtEnd must be at most %s apart", ErrInvalidFilter, MaxMatchWindow)
+	}
+
+	return nil
 }
 
 // Match contains information about a match.
@@ -34,24 +88,43 @@ type Match struct {
 	Spectators   interface{}
 }
 
-// SingleMatchFromResponse returns a single Match from a Response.
-// See Response in client.go.
-func SingleMatchFromResponse(res Response) Match {
-	data := res.Data.(map[string]interface{})
-	links := data["links"].(map[string]interface{})
-	attributes := data["attributes"].(map[string]interface{})
-	stats := attributes["stats"].(map[string]interface{})
+// matchAttributes are the attributes of a match resource.
+type matchAttributes struct {
+	CreatedAt    string       `json:"createdAt"`
+	Duration     truncatedInt `json:"duration"`
+	GameMode     string       `json:"gameMode"`
+	PatchVersion string       `json:"patchVersion"`
+	ShardID      string       `json:"shardId"`
+	TitleID      string       `json:"titleId"`
+	Stats        struct {
+		Type  string `json:"type"`
+		MapID string `json:"mapID"`
+	} `json:"stats"`
+}
 
-	relationships := data["relationships"].(map[string]interface{})
+// SingleMatchFromResponse returns a single Match from a Response.
+// See Response in jsonapi.go.
+func SingleMatchFromResponse(res Response) (Match, error) {
+	resource, err := res.Resource()
+	if err != nil {
+		return Match{}, err
+	}
 
-	assetsData := relationships["assets"].(map[string]interface{})["data"].([]interface{})
-	rostersData := relationships["rosters"].(map[string]interface{})["data"].([]interface{})
-	roundsData := relationships["rounds"].(map[string]interface{})["data"].([]interface{})
+	return SingleMatchFromResource(resource, indexResources(res.Included))
+}
 
-	spectators := relationships["spectators"].(map[string]interface{})
-	spectatorsData := spectators["data"]
+// SingleMatchFromResource returns a single Match from a match resource and the included
+// resources it relates to, keyed by their identifiers.
+func SingleMatchFromResource(resource Resource, included map[ResourceIdentifier]Resource) (Match, error) {
+	attributes := matchAttributes{}
+	if err := resource.decodeAttributes(&attributes); err != nil {
+		return Match{}, err
+	}
 
-	included := res.Included.([]interface{})
+	spectatorsData, err := toGeneric(resource.Relationships["spectators"].Data)
+	if err != nil {
+		return Match{}, err
+	}
 
 	participantList := []Participant{}
 	rosterList := []Roster{}
@@ -59,99 +132,104 @@ func SingleMatchFromResponse(res Response) Match {
 	roundList := []Round{}
 	asset := Asset{}
 
-	for _, incl := range included {
-		switch incl.(map[string]interface{})["type"] {
-		case "roster":
-			for _, dat := range rostersData {
-				if incl.(map[string]interface{})["id"] == dat.(map[string]interface{})["id"] {
-					roster := SingleRosterFromData(incl.(map[string]interface{}))
-					rosterList = append(rosterList, roster)
-				}
-			}
-		case "round":
-			for _, dat := range roundsData {
-				if incl.(map[string]interface{})["id"] == dat.(map[string]interface{})["id"] {
-					round := SingleRoundFromData(incl.(map[string]interface{}))
-					roundList = append(roundList, round)
-				}
-			}
-		case "asset":
-			for _, dat := range assetsData {
-				if incl.(map[string]interface{})["id"] == dat.(map[string]interface{})["id"] {
-					asset = SingleAssetFromData(incl.(map[string]interface{}))
-				}
-			}
+	assets, err := resource.related("assets", included)
+	if err != nil {
+		return Match{}, err
+	}
+	for _, incl := range assets {
+		asset, err = SingleAssetFromResource(incl)
+		if err != nil {
+			return Match{}, err
 		}
 	}
 
-	for _, incl := range included {
-		switch incl.(map[string]interface{})["type"] {
-		case "participant":
-			for _, rost := range rosterList {
-				for _, ply := range rost.Participants.([]interface{}) {
-					if ply.(map[string]interface{})["id"] == incl.(map[string]interface{})["id"] {
-						partic := SingleParticipantFromData(incl.(map[string]interface{}))
-						participantList = append(participantList, partic)
-					}
-				}
-			}
+	rounds, err := resource.related("rounds", included)
+	if err != nil {
+		return Match{}, err
+	}
+	for _, incl := range rounds {
+		round, err := SingleRoundFromResource(incl)
+		if err != nil {
+			return Match{}, err
 		}
+		roundList = append(roundList, round)
+	}
+
+	rosters, err := resource.related("rosters", included)
+	if err != nil {
+		return Match{}, err
 	}
+	for _, incl := range rosters {
+		roster, err := SingleRosterFromResource(incl)
+		if err != nil {
+			return Match{}, err
+		}
+		rosterList = append(rosterList, roster)
+
+		participants, err := incl.related("participants", included)
+		if err != nil {
+			return Match{}, err
+		}
+		for _, partIncl := range participants {
+			partic, err := SingleParticipantFromResource(partIncl)
+			if err != nil {
+				return Match{}, err
+			}
+			participantList = append(participantList, partic)
 
-	for _, incl := range included {
-		switch incl.(map[string]interface{})["type"] {
-		case "player":
-			for _, dat := range participantList {
-				tempID := dat.Relationships.(map[string]interface{})["player"].(map[string]interface{})["data"].(map[string]interface{})["id"]
-				if tempID == incl.(map[string]interface{})["id"] {
-					matchPlr := SingleMatchPlayerFromData(incl.(map[string]interface{}))
-					matchPlayerList = append(matchPlayerList, matchPlr)
+			players, err := partIncl.related("player", included)
+			if err != nil {
+				return Match{}, err
+			}
+			for _, plrIncl := range players {
+				matchPlr, err := SingleMatchPlayerFromResource(plrIncl)
+				if err != nil {
+					return Match{}, err
 				}
+				matchPlayerList = append(matchPlayerList, matchPlr)
 			}
 		}
 	}
 
 	return Match{
-		Type:         data["type"].(string),
-		ID:           data["id"].(string),
-		LinkSelf:     links["self"].(string),
-		CreatedAt:    attributes["createdAt"].(string),
-		Duration:     int(attributes["duration"].(float64)),
-		GameMode:     attributes["gameMode"].(string),
-		PatchVersion: attributes["patchVersion"].(string),
-		ShardID:      attributes["shardId"].(string),
-		MapType:      stats["type"].(string),
-		MapID:        stats["mapID"].(string),
+		Type:         resource.Type,
+		ID:           resource.ID,
+		LinkSelf:     resource.Links["self"],
+		CreatedAt:    attributes.CreatedAt,
+		Duration:     int(attributes.Duration),
+		GameMode:     attributes.GameMode,
+		PatchVersion: attributes.PatchVersion,
+		ShardID:      attributes.ShardID,
+		TitleID:      attributes.TitleID,
+		MapType:      attributes.Stats.Type,
+		MapID:        attributes.Stats.MapID,
 		Asset:        asset,
 		Participants: participantList,
 		Rosters:      rosterList,
 		MatchPlayers: matchPlayerList,
 		Rounds:       roundList,
 		Spectators:   spectatorsData,
-	}
+	}, nil
 }
 
 // MultiMatchesFromResponse returns a slice of Matches from from a Response.
-// See Response in client.go.
-func MultiMatchesFromResponse(res Response) []Match {
-	responses := []Response{}
+// See Response in jsonapi.go.
+func MultiMatchesFromResponse(res Response) ([]Match, error) {
 	matches := []Match{}
 
-	for _, data := range res.Data.([]interface{}) {
-		temp := Response{
-			Data: data,
-		}
-		responses = append(responses, temp)
+	resources, err := res.Resources()
+	if err != nil {
+		return matches, err
 	}
 
-	for i := range responses {
-		responses[i].Included = res.Included
-	}
-
-	for _, singRes := range responses {
-		match := SingleMatchFromResponse(singRes)
+	included := indexResources(res.Included)
+	for _, resource := range resources {
+		match, err := SingleMatchFromResource(resource, included)
+		if err != nil {
+			return matches, err
+		}
 		matches = append(matches, match)
 	}
 
-	return matches
+	return matches, nil
 }
@@ -0,0 +1,104 @@
+package battleritego
+
+import "context"
+
+// MatchIterator iterates over every match of a MatchFilter, requesting the next page
+// of matches by following the "next" link of the previous one.
+// See Matches in client.go.
+//
+//	it := client.Matches(filter)
+//	for it.Next() {
+//		match := it.Match()
+//	}
+//	if err := it.Err(); err != nil {
+//		log.Fatal(err)
+//	}
+type MatchIterator struct {
+	// MaxCount stops the iteration after that many matches, zero means no limit.
+	MaxCount int
+
+	client  Client
+	ctx     context.Context
+	nextURL string
+	visited map[string]bool
+	page    []Match
+	match   Match
+	info    ResponseInfo
+	count   int
+	err     error
+}
+
+// Matches returns a MatchIterator over the matches of filter, starting at the page the
+// filter describes.
+func (client Client) Matches(filter MatchFilter) *MatchIterator {
+	return client.MatchesContext(context.Background(), filter)
+}
+
+// MatchesContext is like Matches but uses ctx for every page request, canceling ctx
+// stops the iteration.
+// An invalid filter is reported by Err without requesting any page.
+func (client Client) MatchesContext(ctx context.Context, filter MatchFilter) *MatchIterator {
+	URL, err := client.matchesURL(filter)
+
+	return &MatchIterator{
+		client:  client,
+		ctx:     ctx,
+		nextURL: URL,
+		visited: map[string]bool{},
+		err:     err,
+	}
+}
+
+// Next advances to the next match, requesting the next page when needed.
+// It returns false once the matches are exhausted, MaxCount is reached or an error occurs.
+func (it *MatchIterator) Next() bool {
+	if it.err != nil || (it.MaxCount > 0 && it.count >= it.MaxCount) {
+		return false
+	}
+
+	for len(it.page) == 0 {
+		if it.nextURL == "" {
+			return false
+		}
+		if err := it.ctx.Err(); err != nil {
+			it.err = err
+			return false
+		}
+
+		it.visited[it.nextURL] = true
+		page, err := it.client.getMatchesPage(it.ctx, it.nextURL)
+		it.info = page.ResponseInfo
+		if err != nil {
+			it.err = err
+			return false
+		}
+
+		// Stop at an empty page or a next link pointing back at a page already requested.
+		next := page.NextURL()
+		if len(page.Matches) == 0 || it.visited[next] {
+			next = ""
+		}
+		it.nextURL = next
+		it.page = page.Matches
+	}
+
+	it.match = it.page[0]
+	it.page = it.page[1:]
+	it.count++
+	return true
+}
+
+// Match returns the current match, it is only valid after Next returned true.
+func (it *MatchIterator) Match() Match {
+	return it.match
+}
+
+// Info returns information about the response of the most recently requested page.
+func (it *MatchIterator) Info() ResponseInfo {
+	return it.info
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *MatchIterator) Err() error {
+	return it.err
+}
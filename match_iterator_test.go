@@ -0,0 +1,121 @@
+package battleritego
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+func testMatch(id string) string {
+	return `{"type":"match","id":"` + id + `","attributes":{"duration":1}}`
+}
+
+func TestMatchIterator(t *testing.T) {
+	var requests int32
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		next := server.URL + "/shards/global/matches?page[offset]="
+		switch r.URL.Query().Get("page[offset]") {
+		case "":
+			fmt.Fprintf(w, `{"data":[%s,%s],"links":{"next":"%s2"}}`, testMatch("a"), testMatch("b"), next)
+		case "2":
+			fmt.Fprintf(w, `{"data":[%s],"links":{"next":"%s3"}}`, testMatch("c"), next)
+		case "3":
+			// An empty page ends the iteration even with a next link.
+			fmt.Fprintf(w, `{"data":[],"links":{"next":"%s4"}}`, next)
+		default:
+			t.Errorf("unexpected request for %s", r.URL)
+		}
+	}))
+	defer server.Close()
+	client := NewClient("key", WithAPIURL(server.URL))
+
+	ids := ""
+	it := client.Matches(MatchFilter{})
+	for it.Next() {
+		ids += it.Match().ID
+	}
+	if it.Err() != nil || ids != "abc" {
+		t.Errorf("iterated %q, %v, want abc", ids, it.Err())
+	}
+	if got := atomic.LoadInt32(&requests); got != 3 {
+		t.Errorf("%d requests, want 3", got)
+	}
+
+	atomic.StoreInt32(&requests, 0)
+	it = client.Matches(MatchFilter{})
+	it.MaxCount = 2
+	count := 0
+	for it.Next() {
+		count++
+	}
+	if count != 2 || atomic.LoadInt32(&requests) != 1 {
+		t.Errorf("MaxCount 2 iterated %d matches with %d requests", count, requests)
+	}
+
+	it = client.Matches(MatchFilter{PageLimit: MaxMatchPageLimit + 1})
+	if it.Next() || !errors.Is(it.Err(), ErrInvalidFilter) {
+		t.Errorf("invalid filter Err() = %v, want ErrInvalidFilter", it.Err())
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	it = client.MatchesContext(ctx, MatchFilter{})
+	if it.Next() || !errors.Is(it.Err(), context.Canceled) {
+		t.Errorf("canceled context Err() = %v, want context.Canceled", it.Err())
+	}
+}
+
+func TestMatchIteratorSelfLink(t *testing.T) {
+	var requests int32
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		fmt.Fprintf(w, `{"data":[%s],"links":{"next":"%s"}}`, testMatch("a"), server.URL+r.URL.RequestURI())
+	}))
+	defer server.Close()
+
+	client := NewClient("key", WithAPIURL(server.URL))
+	it := client.Matches(MatchFilter{})
+	count := 0
+	for it.Next() {
+		count++
+	}
+	if count != 1 || atomic.LoadInt32(&requests) != 1 {
+		t.Errorf("iterated %d matches with %d requests, want 1 and 1", count, requests)
+	}
+}
+
+func TestMatchIteratorCycle(t *testing.T) {
+	var requests int32
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		next := server.URL + "/shards/global/matches?page[offset]="
+		// Pages 1 and 2 link to each other.
+		switch r.URL.Query().Get("page[offset]") {
+		case "1":
+			fmt.Fprintf(w, `{"data":[%s],"links":{"next":"%s2"}}`, testMatch("b"), next)
+		case "2":
+			fmt.Fprintf(w, `{"data":[%s],"links":{"next":"%s1"}}`, testMatch("c"), next)
+		default:
+			fmt.Fprintf(w, `{"data":[%s],"links":{"next":"%s1"}}`, testMatch("a"), next)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("key", WithAPIURL(server.URL))
+	it := client.Matches(MatchFilter{})
+	count := 0
+	for it.Next() {
+		count++
+	}
+	if count != 3 || atomic.LoadInt32(&requests) != 3 || it.Err() != nil {
+		t.Errorf("iterated %d matches with %d requests, %v, want 3 and 3", count, requests, it.Err())
+	}
+}
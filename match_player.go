@@ -9,18 +9,33 @@ type MatchPlayer struct {
 	Relationships interface{}
 }
 
-// SingleMatchPlayerFromData returns a MatchPlayer from passed in data
-func SingleMatchPlayerFromData(data map[string]interface{}) MatchPlayer {
-	attributes := data["attributes"].(map[string]interface{})
-	relationships := data["relationships"].(map[string]interface{})
-	assets := relationships["assets"].(map[string]interface{})
-	links := data["links"].(map[string]interface{})
+// SingleMatchPlayerFromResource returns a MatchPlayer from passed in resource
+func SingleMatchPlayerFromResource(resource Resource) (MatchPlayer, error) {
+	attributes, err := toGeneric(resource.Attributes)
+	if err != nil {
+		return MatchPlayer{}, err
+	}
+	assets, err := toGeneric(resource.Relationships["assets"].Data)
+	if err != nil {
+		return MatchPlayer{}, err
+	}
 
 	return MatchPlayer{
-		Type:          data["type"].(string),
-		ID:            data["id"].(string),
-		LinkSelf:      links["self"].(string),
+		Type:          resource.Type,
+		ID:            resource.ID,
+		LinkSelf:      resource.Links["self"],
 		Attributes:    attributes,
-		Relationships: assets["data"],
+		Relationships: assets,
+	}, nil
+}
+
+// SingleMatchPlayerFromData returns a single MatchPlayer from the decoded JSON of a resource.
+//
+// Deprecated: use SingleMatchPlayerFromResource.
+func SingleMatchPlayerFromData(data map[string]interface{}) (MatchPlayer, error) {
+	resource, err := resourceFromData(data)
+	if err != nil {
+		return MatchPlayer{}, err
 	}
+	return SingleMatchPlayerFromResource(resource)
 }
@@ -0,0 +1,90 @@
+package battleritego
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+const testMatchResponse = `{
+	"data": {
+		"type": "match", "id": "m1",
+		"attributes": {"createdAt": "2018-01-01T00:00:00Z", "duration": 300, "gameMode": "1733162751",
+			"stats": {"type": "QUICK2V2", "mapID": "map"}},
+		"relationships": {
+			"assets": {"data": [{"type": "asset", "id": "a1"}]},
+			"rosters": {"data": [{"type": "roster", "id": "r1"}]},
+			"rounds": {"data": [{"type": "round", "id": "rd1"}, {"type": "round", "id": "rd2"}]},
+			"spectators": {"data": []}
+		}
+	},
+	"included": [
+		{"type": "asset", "id": "a1", "attributes": {"URL": "https://cdn/telemetry.json", "name": "telemetry"}},
+		{"type": "round", "id": "rd1", "attributes": {"duration": 60, "ordinal": 1, "stats": {"winningTeam": 1}}},
+		{"type": "round", "id": "rd2", "attributes": {"duration": 70.5, "ordinal": 2, "stats": {"winningTeam": 2}}},
+		{"type": "roster", "id": "r1", "attributes": {"won": "true", "stats": {"score": 3}},
+			"relationships": {"participants": {"data": [{"type": "participant", "id": "p1"}]}}},
+		{"type": "participant", "id": "p1", "attributes": {"actor": "12", "stats": {"userID": "42", "damageDone": 12.5, "kills": 2}},
+			"relationships": {"player": {"data": {"type": "player", "id": "42"}}}},
+		{"type": "player", "id": "42", "attributes": {"name": "P1"}}
+	]
+}`
+
+func TestSingleMatchFromResponse(t *testing.T) {
+	res := Response{}
+	if err := json.Unmarshal([]byte(testMatchResponse), &res); err != nil {
+		t.Fatal(err)
+	}
+
+	match, err := SingleMatchFromResponse(res)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if match.ID != "m1" || match.Duration != 300 || match.MapType != "QUICK2V2" || match.MapID != "map" {
+		t.Errorf("match = %+v", match)
+	}
+	if match.Asset.URL != "https://cdn/telemetry.json" {
+		t.Errorf("Asset.URL = %q", match.Asset.URL)
+	}
+	if len(match.Rounds) != 2 || match.Rounds[1].Duration != 70 || match.Rounds[1].WinningTeam != 2 {
+		t.Errorf("Rounds = %+v", match.Rounds)
+	}
+	if len(match.Rosters) != 1 || !match.Rosters[0].Won || match.Rosters[0].Score != 3 {
+		t.Errorf("Rosters = %+v", match.Rosters)
+	}
+	if len(match.Participants) != 1 {
+		t.Fatalf("%d participants, want 1", len(match.Participants))
+	}
+	if p := match.Participants[0]; p.Actor != 12 || p.UserID != 42 || p.DamageDone != 12 || p.Kills != 2 {
+		t.Errorf("Participants[0] = %+v", p)
+	}
+	if len(match.MatchPlayers) != 1 || match.MatchPlayers[0].ID != "42" {
+		t.Errorf("MatchPlayers = %+v", match.MatchPlayers)
+	}
+}
+
+func TestResponseResourceNull(t *testing.T) {
+	for _, body := range []string{`{"data": null}`, `{}`} {
+		res := Response{}
+		if err := json.Unmarshal([]byte(body), &res); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := res.Resource(); !errors.Is(err, ErrNotFound) {
+			t.Errorf("%s: Resource() error = %v, want ErrNotFound", body, err)
+		}
+	}
+}
+
+func TestSingleParticipantFromData(t *testing.T) {
+	data := map[string]interface{}{
+		"type": "participant",
+		"id":   "p1",
+		"attributes": map[string]interface{}{
+			"stats": map[string]interface{}{"userID": "42", "score": 10.0},
+		},
+	}
+	participant, err := SingleParticipantFromData(data)
+	if err != nil || participant.ID != "p1" || participant.UserID != 42 || participant.Score != 10 {
+		t.Errorf("SingleParticipantFromData() = %+v, %v", participant, err)
+	}
+}
@@ -0,0 +1,96 @@
+package battleritego
+
+import (
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Option configures a Client created with NewClient.
+type Option func(*Client)
+
+// WithHTTPClient sets the http client used to send requests.
+// Options such as WithTimeout and WithTransport passed after it configure a copy
+// of httpClient, the passed in client itself is never changed.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(client *Client) {
+		client.httpClient = httpClient
+	}
+}
+
+// WithTransport sets the RoundTripper used to send requests.
+func WithTransport(transport http.RoundTripper) Option {
+	return func(client *Client) {
+		httpClient := *client.http()
+		httpClient.Transport = transport
+		client.httpClient = &httpClient
+	}
+}
+
+// WithTimeout sets the time limit for each request, a timeout of zero means no timeout.
+func WithTimeout(timeout time.Duration) Option {
+	return func(client *Client) {
+		httpClient := *client.http()
+		httpClient.Timeout = timeout
+		client.httpClient = &httpClient
+	}
+}
+
+// WithAPIURL sets the root of the API, replacing APIURL. The shard is appended to it,
+// so URL is the part before "shards/", unlike the BaseURL constant.
+// This is mainly useful to point a Client at a local mock server.
+func WithAPIURL(URL string) Option {
+	return func(client *Client) {
+		if !strings.HasSuffix(URL, "/") {
+			URL += "/"
+		}
+		client.apiURL = URL
+	}
+}
+
+// WithShard sets the shard requests are sent to, replacing DefaultShard.
+// See: https://battlerite-docs.readthedocs.io/en/latest/datacenters/datacenters.html
+func WithShard(shard string) Option {
+	return func(client *Client) {
+		client.shard = shard
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with each request.
+func WithUserAgent(userAgent string) Option {
+	return func(client *Client) {
+		client.userAgent = userAgent
+	}
+}
+
+// WithRateLimitWait sets whether requests wait for the rate limit to reset once the request
+// budget is used up, instead of returning an error matching ErrRateLimited.
+func WithRateLimitWait(wait bool) Option {
+	return func(client *Client) {
+		if client.rateLimiter == nil {
+			client.rateLimiter = &rateLimiter{}
+		}
+		client.rateLimiter.wait = wait
+	}
+}
+
+// WithRetryPolicy sets how failed requests are retried, see DefaultRetryPolicy.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(client *Client) {
+		client.retryPolicy = policy
+	}
+}
+
+// WithCache sets the Cache responses are stored in, see CacheTTLs for what is cached.
+func WithCache(cache Cache) Option {
+	return func(client *Client) {
+		client.cache = cache
+	}
+}
+
+// WithCacheTTLs sets how long each kind of response is cached, replacing DefaultCacheTTLs.
+func WithCacheTTLs(ttls CacheTTLs) Option {
+	return func(client *Client) {
+		client.cacheTTLs = ttls
+	}
+}
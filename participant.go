@@ -32,39 +32,84 @@ type Participant struct {
 	Relationships    interface{}
 }
 
-// SingleParticipantFromData returns a single participant from data.
-func SingleParticipantFromData(data map[string]interface{}) Participant {
-	attributes := data["attributes"].(map[string]interface{})
-	stats := attributes["stats"].(map[string]interface{})
-	relationships := data["relationships"].(map[string]interface{})
+// participantAttributes are the attributes of a participant resource.
+type participantAttributes struct {
+	Actor   string `json:"actor"`
+	ShardID string `json:"shardId"`
+	Stats   struct {
+		UserID           string       `json:"userID"`
+		DamageDone       truncatedInt `json:"damageDone"`
+		DamageReceived   truncatedInt `json:"damageReceived"`
+		Deaths           truncatedInt `json:"deaths"`
+		EnergyGained     truncatedInt `json:"energyGained"`
+		EnergyUsed       truncatedInt `json:"energyUsed"`
+		Kills            truncatedInt `json:"kills"`
+		Score            truncatedInt `json:"score"`
+		TimeAlive        truncatedInt `json:"timeAlive"`
+		AbilityUses      truncatedInt `json:"abilityUses"`
+		DisablesDone     truncatedInt `json:"disablesDone"`
+		DisablesReceived truncatedInt `json:"disablesReceived"`
+		Emote            truncatedInt `json:"emote"`
+		Mount            truncatedInt `json:"mount"`
+		Outfit           truncatedInt `json:"outfit"`
+		Attachment       truncatedInt `json:"attachment"`
+		HealingDone      truncatedInt `json:"healingDone"`
+		HealingReceived  truncatedInt `json:"healingReceived"`
+		Side             truncatedInt `json:"side"`
+	} `json:"stats"`
+}
+
+// SingleParticipantFromResource returns a single participant from a resource.
+func SingleParticipantFromResource(resource Resource) (Participant, error) {
+	attributes := participantAttributes{}
+	if err := resource.decodeAttributes(&attributes); err != nil {
+		return Participant{}, err
+	}
 
-	actor, _ := strconv.Atoi(attributes["actor"].(string))
-	userID, _ := strconv.Atoi(stats["userID"].(string))
+	relationships, err := toGeneric(resource.Relationships)
+	if err != nil {
+		return Participant{}, err
+	}
+
+	stats := attributes.Stats
+	actor, _ := strconv.Atoi(attributes.Actor)
+	userID, _ := strconv.Atoi(stats.UserID)
 
 	return Participant{
-		Type:             data["type"].(string),
-		ID:               data["id"].(string),
+		Type:             resource.Type,
+		ID:               resource.ID,
 		Actor:            actor,
-		ShardID:          attributes["shardId"].(string),
+		ShardID:          attributes.ShardID,
 		UserID:           userID,
-		DamageDone:       int(stats["damageDone"].(float64)),
-		DamageReceived:   int(stats["damageReceived"].(float64)),
-		Deaths:           int(stats["deaths"].(float64)),
-		EnergyGained:     int(stats["energyGained"].(float64)),
-		EnergyUsed:       int(stats["energyUsed"].(float64)),
-		Kills:            int(stats["kills"].(float64)),
-		Score:            int(stats["score"].(float64)),
-		TimeAlive:        int(stats["timeAlive"].(float64)),
-		AbilityUses:      int(stats["abilityUses"].(float64)),
-		DisablesDone:     int(stats["disablesDone"].(float64)),
-		DisablesReceived: int(stats["disablesReceived"].(float64)),
-		Emote:            int(stats["emote"].(float64)),
-		Mount:            int(stats["mount"].(float64)),
-		Outfit:           int(stats["outfit"].(float64)),
-		Attachment:       int(stats["attachment"].(float64)),
-		HealingDone:      int(stats["healingDone"].(float64)),
-		HealingReceived:  int(stats["healingReceived"].(float64)),
-		Side:             int(stats["side"].(float64)),
+		DamageDone:       int(stats.DamageDone),
+		DamageReceived:   int(stats.DamageReceived),
+		Deaths:           int(stats.Deaths),
+		EnergyGained:     int(stats.EnergyGained),
+		EnergyUsed:       int(stats.EnergyUsed),
+		Kills:            int(stats.Kills),
+		Score:            int(stats.Score),
+		TimeAlive:        int(stats.TimeAlive),
+		AbilityUses:      int(stats.AbilityUses),
+		DisablesDone:     int(stats.DisablesDone),
+		DisablesReceived: int(stats.DisablesReceived),
+		Emote:            int(stats.Emote),
+		Mount:            int(stats.Mount),
+		Outfit:           int(stats.Outfit),
+		Attachment:       int(stats.Attachment),
+		HealingDone:      int(stats.HealingDone),
+		HealingReceived:  int(stats.HealingReceived),
+		Side:             int(stats.Side),
 		Relationships:    relationships,
+	}, nil
+}
+
+// SingleParticipantFromData returns a single Participant from the decoded JSON of a resource.
+//
+// Deprecated: use SingleParticipantFromResource.
+func SingleParticipantFromData(data map[string]interface{}) (Participant, error) {
+	resource, err := resourceFromData(data)
+	if err != nil {
+		return Participant{}, err
 	}
+	return SingleParticipantFromResource(resource)
 }
@@ -60,6 +60,7 @@ type Player struct {
 // PlayerFilter contains filters for searching for battlerite users using GetPlayerFilter() in client.go.
 // It should be noted if more than one field is used to sorting the API will return only the first.
 // In most cases only one field should be used for filtering at one time.
+// LookupPlayers in player_lookup.go uses every field and any number of values.
 // See: https://battlerite-docs.readthedocs.io/en/master/players/players.html#get-a-collection-of-players
 type PlayerFilter struct {
 	Names    []string
@@ -113,39 +114,43 @@ func GetChampionData(stats map[string]interface{}, startIndex int) map[string]in
 			log.Fatal(err)
 		}
 
-		if stats[strconv.Itoa(startIndex+champID)] == nil {
-			champXP[string(k)] = 0
-		} else {
-			champXP[string(k)] = int(stats[strconv.Itoa(startIndex+champID)].(float64))
-		}
-
+		champXP[string(k)] = zeroIfNil(stats[strconv.Itoa(startIndex+champID)])
 	}
 
 	return champXP
 }
 
-// Returns some data or 0 if the data is nil
+// Returns some data or 0 if the data is nil or not a number
 func zeroIfNil(in interface{}) int {
-	if in != nil {
-		return int(in.(float64))
+	if num, ok := in.(float64); ok {
+		return int(num)
 	}
 	return 0
 }
 
-// SinglePlayerFromData creates a player out of the data of a single battlerite user
-func SinglePlayerFromData(data map[string]interface{}) Player {
-	links := data["links"].(map[string]interface{})
-	attributes := data["attributes"].(map[string]interface{})
-	stats := attributes["stats"].(map[string]interface{})
+// playerAttributes are the attributes of a player resource.
+type playerAttributes struct {
+	Name    string                 `json:"name"`
+	TitleID string                 `json:"titleId"`
+	Stats   map[string]interface{} `json:"stats"`
+}
+
+// SinglePlayerFromResource creates a player out of the resource of a single battlerite user
+func SinglePlayerFromResource(resource Resource) (Player, error) {
+	attributes := playerAttributes{}
+	if err := resource.decodeAttributes(&attributes); err != nil {
+		return Player{}, err
+	}
+	stats := attributes.Stats
 
-	id, _ := strconv.Atoi(data["id"].(string))
+	id, _ := strconv.Atoi(resource.ID)
 
 	return Player{
-		Type:                         data["type"].(string),
+		Type:                         resource.Type,
 		ID:                           id,
-		LinkSelf:                     links["self"].(string),
-		TitleID:                      attributes["titleId"].(string),
-		Name:                         attributes["name"].(string),
+		LinkSelf:                     resource.Links["self"],
+		TitleID:                      attributes.TitleID,
+		Name:                         attributes.Name,
 		Picture:                      zeroIfNil(stats["picture"]),
 		Wins:                         zeroIfNil(stats["2"]),
 		Losses:                       zeroIfNil(stats["3"]),
@@ -188,21 +193,42 @@ func SinglePlayerFromData(data map[string]interface{}) Player {
 		CharacterBattlegroundsWins:   GetChampionData(stats, 27000),
 		CharacterBattlegroundsLosses: GetChampionData(stats, 28000),
 		CharacterLevels:              GetChampionData(stats, 40000),
+	}, nil
+}
+
+// SinglePlayerFromData returns a single Player from the decoded JSON of a resource.
+//
+// Deprecated: use SinglePlayerFromResource.
+func SinglePlayerFromData(data map[string]interface{}) (Player, error) {
+	resource, err := resourceFromData(data)
+	if err != nil {
+		return Player{}, err
 	}
+	return SinglePlayerFromResource(resource)
 }
 
-// MultiPlayersFromData creates a slice of players out of a slice of battlerite user datas
-func MultiPlayersFromData(data []interface{}) ([]Player, error) {
+// MultiPlayersFromResources creates a slice of players out of a slice of battlerite user resources
+func MultiPlayersFromResources(resources []Resource) ([]Player, error) {
 	playerDatas := []Player{}
 
-	if len(data) < 1 {
-		return playerDatas, nil
-	}
-
-	for _, tempData := range data {
-		player := SinglePlayerFromData(tempData.(map[string]interface{}))
+	for _, resource := range resources {
+		player, err := SinglePlayerFromResource(resource)
+		if err != nil {
+			return playerDatas, err
+		}
 		playerDatas = append(playerDatas, player)
 	}
 
 	return playerDatas, nil
 }
+
+// MultiPlayersFromData returns a slice of players from the decoded JSON of an array of resources.
+//
+// Deprecated: use MultiPlayersFromResources.
+func MultiPlayersFromData(data []interface{}) ([]Player, error) {
+	resources, err := resourcesFromData(data)
+	if err != nil {
+		return []Player{}, err
+	}
+	return MultiPlayersFromResources(resources)
+}
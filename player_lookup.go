@@ -0,0 +1,189 @@
+package battleritego
+
+import (
+	"context"
+	"strings"
+	"sync"
+)
+
+// MaxPlayerIDsPerRequest is the most names, user IDs or Steam IDs the API accepts in a
+// single players request.
+const MaxPlayerIDsPerRequest = 6
+
+// PlayerLookup is the result of LookupPlayers.
+// Players holds every player found once, in the order they were requested.
+// MissingNames and MissingUserIDs hold what was requested but not returned by the API.
+// Players carry no Steam ID, so Steam IDs that were not found can't be reported.
+type PlayerLookup struct {
+	Players        []Player
+	MissingNames   []string
+	MissingUserIDs []int
+}
+
+// LookupPlayers requests every player of filter, however many names and IDs it holds.
+// Unlike GetPlayersFiltered every field of the filter is used. The lists are de-duplicated
+// and split into requests of at most MaxPlayerIDsPerRequest, running up to concurrency of
+// them at once, or one at a time if concurrency is below one.
+// If a request fails, the players found by the others are returned with its error.
+func (client Client) LookupPlayers(filter PlayerFilter, concurrency int) (PlayerLookup, error) {
+	return client.LookupPlayersContext(context.Background(), filter, concurrency)
+}
+
+// LookupPlayersContext is like LookupPlayers but uses ctx for the requests.
+func (client Client) LookupPlayersContext(ctx context.Context, filter PlayerFilter, concurrency int) (PlayerLookup, error) {
+	names := uniqueStrings(filter.Names)
+	userIDs := uniqueInts(filter.UserIDs)
+	steamIDs := uniqueInts(filter.SteamIDs)
+
+	filters := []PlayerFilter{}
+	for _, chunk := range chunkStrings(names, MaxPlayerIDsPerRequest) {
+		filters = append(filters, PlayerFilter{Names: chunk})
+	}
+	for _, chunk := range chunkInts(userIDs, MaxPlayerIDsPerRequest) {
+		filters = append(filters, PlayerFilter{UserIDs: chunk})
+	}
+	for _, chunk := range chunkInts(steamIDs, MaxPlayerIDsPerRequest) {
+		filters = append(filters, PlayerFilter{SteamIDs: chunk})
+	}
+
+	results := make([][]Player, len(filters))
+	err := forEachConcurrently(ctx, len(filters), concurrency, func(ctx context.Context, i int) error {
+		players, err := client.GetPlayersFilteredContext(ctx, filters[i])
+		results[i] = players
+		return err
+	})
+
+	lookup := PlayerLookup{
+		Players:        []Player{},
+		MissingNames:   []string{},
+		MissingUserIDs: []int{},
+	}
+	foundIDs := map[int]bool{}
+	foundNames := map[string]bool{}
+	for _, players := range results {
+		for _, player := range players {
+			foundNames[strings.ToLower(player.Name)] = true
+			if foundIDs[player.ID] {
+				continue
+			}
+			foundIDs[player.ID] = true
+			lookup.Players = append(lookup.Players, player)
+		}
+	}
+
+	// Only report what was actually requested without an error as missing.
+	if err == nil {
+		for _, name := range names {
+			if !foundNames[strings.ToLower(name)] {
+				lookup.MissingNames = append(lookup.MissingNames, name)
+			}
+		}
+		for _, id := range userIDs {
+			if !foundIDs[id] {
+				lookup.MissingUserIDs = append(lookup.MissingUserIDs, id)
+			}
+		}
+	}
+
+	return lookup, err
+}
+
+// forEachConcurrently calls fn for every index below n, running up to concurrency calls at once.
+// The first error returned cancels the context of the remaining calls and is returned.
+func forEachConcurrently(ctx context.Context, n, concurrency int, fn func(ctx context.Context, i int) error) error {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+	)
+	fail := func(err error) {
+		once.Do(func() {
+			firstErr = err
+			cancel()
+		})
+	}
+
+	sem := make(chan struct{}, concurrency)
+	for i := 0; i < n; i++ {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if err := ctx.Err(); err != nil {
+			fail(err)
+			break
+		}
+
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			if err := fn(ctx, i); err != nil {
+				fail(err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	return firstErr
+}
+
+// uniqueStrings returns values without duplicates, keeping the first of each.
+func uniqueStrings(values []string) []string {
+	seen := map[string]bool{}
+	unique := []string{}
+	for _, value := range values {
+		if !seen[value] {
+			seen[value] = true
+			unique = append(unique, value)
+		}
+	}
+	return unique
+}
+
+// uniqueInts returns values without duplicates, keeping the first of each.
+func uniqueInts(values []int) []int {
+	seen := map[int]bool{}
+	unique := []int{}
+	for _, value := range values {
+		if !seen[value] {
+			seen[value] = true
+			unique = append(unique, value)
+		}
+	}
+	return unique
+}
+
+// chunkStrings splits values into slices of at most size values.
+func chunkStrings(values []string, size int) [][]string {
+	chunks := [][]string{}
+	for len(values) > size {
+		chunks = append(chunks, values[:size])
+		values = values[size:]
+	}
+	if len(values) > 0 {
+		chunks = append(chunks, values)
+	}
+	return chunks
+}
+
+// chunkInts splits values into slices of at most size values.
+func chunkInts(values []int, size int) [][]int {
+	chunks := [][]int{}
+	for len(values) > size {
+		chunks = append(chunks, values[:size])
+		values = values[size:]
+	}
+	if len(values) > 0 {
+		chunks = append(chunks, values)
+	}
+	return chunks
+}
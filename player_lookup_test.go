@@ -0,0 +1,108 @@
+package battleritego
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestForEachConcurrently(t *testing.T) {
+	var running, peak, calls int32
+	err := forEachConcurrently(context.Background(), 20, 3, func(ctx context.Context, i int) error {
+		atomic.AddInt32(&calls, 1)
+		n := atomic.AddInt32(&running, 1)
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		atomic.AddInt32(&running, -1)
+		return nil
+	})
+	if err != nil || calls != 20 || peak > 3 {
+		t.Errorf("forEachConcurrently() = %v with %d calls, %d at once", err, calls, peak)
+	}
+}
+
+func TestForEachConcurrentlyCancel(t *testing.T) {
+	failure := errors.New("failure")
+	var calls int32
+	err := forEachConcurrently(context.Background(), 100, 2, func(ctx context.Context, i int) error {
+		atomic.AddInt32(&calls, 1)
+		if i == 1 {
+			return failure
+		}
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	if !errors.Is(err, failure) {
+		t.Errorf("forEachConcurrently() error = %v, want the first failure", err)
+	}
+	if got := atomic.LoadInt32(&calls); got > 3 {
+		t.Errorf("%d calls after a failure, want the rest canceled", got)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	calls = 0
+	err = forEachConcurrently(ctx, 10, 2, func(ctx context.Context, i int) error {
+		atomic.AddInt32(&calls, 1)
+		return nil
+	})
+	if !errors.Is(err, context.Canceled) || calls != 0 {
+		t.Errorf("forEachConcurrently() with a canceled context = %v after %d calls", err, calls)
+	}
+}
+
+func TestLookupPlayers(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		query := r.URL.Query()
+
+		data := []string{}
+		if ids := query.Get("filter[playerIds]"); ids != "" {
+			for _, id := range strings.Split(ids, ",") {
+				if id != "13" {
+					data = append(data, fmt.Sprintf(`{"type":"player","id":"%s","attributes":{"name":"p%s","stats":{}}}`, id, id))
+				}
+			}
+		}
+		if names := query.Get("filter[playerNames]"); names != "" {
+			for i, name := range strings.Split(names, ",") {
+				data = append(data, fmt.Sprintf(`{"type":"player","id":"%d","attributes":{"name":%q,"stats":{}}}`, 100+i, name))
+			}
+		}
+		fmt.Fprintf(w, `{"data":[%s]}`, strings.Join(data, ","))
+	}))
+	defer server.Close()
+
+	userIDs := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 1, 2}
+	names := []string{"Tom & Jerry", "#1", "a+b"}
+	client := NewClient("key", WithAPIURL(server.URL))
+	lookup, err := client.LookupPlayers(PlayerFilter{UserIDs: userIDs, Names: names}, 2)
+	if err != nil {
+		t.Fatalf("LookupPlayers() error = %v", err)
+	}
+
+	if got := atomic.LoadInt32(&requests); got != 4 {
+		t.Errorf("%d requests, want 4", got)
+	}
+	if len(lookup.Players) != 15 {
+		t.Errorf("found %d players, want 15", len(lookup.Players))
+	}
+	if len(lookup.MissingUserIDs) != 1 || lookup.MissingUserIDs[0] != 13 {
+		t.Errorf("MissingUserIDs = %v, want [13]", lookup.MissingUserIDs)
+	}
+	if len(lookup.MissingNames) != 0 {
+		t.Errorf("MissingNames = %q, escaped names were not found", lookup.MissingNames)
+	}
+}
@@ -0,0 +1,140 @@
+package battleritego
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// RateLimit contains the request budget of an API key as last reported by the API.
+// See https://battlerite-docs.readthedocs.io/en/master/ratelimits/ratelimits.html
+type RateLimit struct {
+	Limit     int
+	Remaining int
+	Reset     time.Time
+}
+
+// rateLimiter tracks the rate limit headers of responses and holds back requests
+// once the budget is used up.
+type rateLimiter struct {
+	mu    sync.Mutex
+	limit RateLimit
+	known bool
+	wait  bool
+}
+
+// current returns the last known rate limit, the bool is false if no response has reported one yet.
+func (limiter *rateLimiter) current() (RateLimit, bool) {
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+
+	return limiter.limit, limiter.known
+}
+
+// acquire takes one request from the budget.
+// If the budget is used up it either waits for the reset time or returns an error wrapping
+// ErrRateLimited, depending on whether waiting is enabled.
+func (limiter *rateLimiter) acquire(ctx context.Context) error {
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		limiter.mu.Lock()
+		if !limiter.known || limiter.limit.Remaining > 0 {
+			limiter.limit.Remaining--
+			limiter.mu.Unlock()
+			return nil
+		}
+
+		reset := limiter.limit.Reset
+		wait := time.Until(reset)
+		if wait <= 0 {
+			// The window has passed, assume the full budget is back until a response says otherwise.
+			// Without a known limit, such as after a 429 without rate limit headers, the budget
+			// is unknown again and backing off is left to the RetryPolicy.
+			limiter.limit.Remaining = limiter.limit.Limit
+			limiter.limit.Reset = time.Time{}
+			if limiter.limit.Limit <= 0 {
+				limiter.known = false
+			}
+			limiter.mu.Unlock()
+			continue
+		}
+		limiter.mu.Unlock()
+
+		if !limiter.wait {
+			return fmt.Errorf("%w until %s; "+
+				"Learn more: https://battlerite-docs.readthedocs.io/en/master/ratelimits/ratelimits.html",
+				ErrRateLimited, reset.Format(time.RFC3339))
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// update records the rate limit headers of a response, headers that are missing are ignored.
+func (limiter *rateLimiter) update(statusCode int, header http.Header) {
+	reported := rateLimitFromHeader(header)
+
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+
+	if header.Get("X-Ratelimit-Limit") != "" {
+		limiter.limit.Limit = reported.Limit
+		limiter.known = true
+	}
+	if header.Get("X-Ratelimit-Remaining") != "" {
+		limiter.limit.Remaining = reported.Remaining
+		limiter.known = true
+	}
+	if statusCode == http.StatusTooManyRequests {
+		limiter.limit.Remaining = 0
+		limiter.known = true
+	}
+	if !reported.Reset.IsZero() {
+		limiter.limit.Reset = reported.Reset
+	}
+}
+
+// rateLimitFromHeader returns the rate limit reported by the headers of a response,
+// fields of missing headers are left zero.
+func rateLimitFromHeader(header http.Header) RateLimit {
+	rateLimit := RateLimit{}
+	rateLimit.Limit, _ = strconv.Atoi(header.Get("X-Ratelimit-Limit"))
+	rateLimit.Remaining, _ = strconv.Atoi(header.Get("X-Ratelimit-Remaining"))
+	if reset, err := strconv.ParseInt(header.Get("X-Ratelimit-Reset"), 10, 64); err == nil {
+		rateLimit.Reset = parseRateLimitReset(reset)
+	}
+	return rateLimit
+}
+
+// parseRateLimitReset returns the time of the X-Ratelimit-Reset header value.
+// The API reports it in nanoseconds, either as a UTC epoch time or as the time left in the
+// current window, so values too small to be a recent epoch time are read as a duration.
+func parseRateLimitReset(reset int64) time.Time {
+	const minEpochNanos = 1e18 // 2001-09-09
+	if reset >= minEpochNanos {
+		return time.Unix(0, reset)
+	}
+	return time.Now().Add(time.Duration(reset))
+}
+
+// RateLimit returns the request budget last reported by the API.
+// The bool is false if no response has reported one yet, or the Client was not created
+// with NewClient, in which case the rate limit is not tracked.
+func (client Client) RateLimit() (RateLimit, bool) {
+	if client.rateLimiter == nil {
+		return RateLimit{}, false
+	}
+	return client.rateLimiter.current()
+}
@@ -0,0 +1,126 @@
+package battleritego
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const testStatus = `{"data":{"type":"status","id":"1","attributes":{"releasedAt":"r","version":"v"}}}`
+
+func TestRateLimitExhausted(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		remaining := "9"
+		if atomic.AddInt32(&requests, 1) == 1 {
+			remaining = "0"
+		}
+		w.Header().Set("X-Ratelimit-Limit", "10")
+		w.Header().Set("X-Ratelimit-Remaining", remaining)
+		w.Header().Set("X-Ratelimit-Reset", strconv.FormatInt((100*time.Millisecond).Nanoseconds(), 10))
+		w.Write([]byte(testStatus))
+	}))
+	defer server.Close()
+
+	client := NewClient("key", WithAPIURL(server.URL))
+	if _, err := client.GetStatus(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.GetStatus(); !errors.Is(err, ErrRateLimited) {
+		t.Fatalf("GetStatus() error = %v, want ErrRateLimited", err)
+	}
+	if got := atomic.LoadInt32(&requests); got != 1 {
+		t.Errorf("%d requests sent, want 1", got)
+	}
+
+	// Once the reset time passes the full budget is assumed to be back.
+	time.Sleep(150 * time.Millisecond)
+	if _, err := client.GetStatus(); err != nil {
+		t.Fatalf("GetStatus() after reset error = %v", err)
+	}
+	if limit, ok := client.RateLimit(); !ok || limit.Remaining != 9 || limit.Limit != 10 {
+		t.Errorf("RateLimit() = %+v, %v", limit, ok)
+	}
+}
+
+func TestRateLimitWait(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		remaining := "9"
+		if atomic.AddInt32(&requests, 1) == 1 {
+			remaining = "0"
+		}
+		w.Header().Set("X-Ratelimit-Limit", "10")
+		w.Header().Set("X-Ratelimit-Remaining", remaining)
+		w.Header().Set("X-Ratelimit-Reset", strconv.FormatInt((100*time.Millisecond).Nanoseconds(), 10))
+		w.Write([]byte(testStatus))
+	}))
+	defer server.Close()
+
+	client := NewClient("key", WithAPIURL(server.URL), WithRateLimitWait(true))
+	if _, err := client.GetStatus(); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := client.GetStatusContext(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("GetStatusContext() error = %v, want context.DeadlineExceeded", err)
+	}
+
+	start := time.Now()
+	if _, err := client.GetStatus(); err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+		t.Errorf("GetStatus() returned after %s without waiting for the reset", elapsed)
+	}
+}
+
+func TestRateLimitTooManyRequests(t *testing.T) {
+	limiter := &rateLimiter{}
+	limiter.update(http.StatusTooManyRequests, http.Header{})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	if err := limiter.acquire(ctx); err != nil {
+		t.Fatalf("acquire() after a 429 without headers error = %v", err)
+	}
+
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := limiter.acquire(canceled); !errors.Is(err, context.Canceled) {
+		t.Errorf("acquire() with a canceled context error = %v", err)
+	}
+
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	client := NewClient("key", WithAPIURL(server.URL), WithRetryPolicy(RetryPolicy{MaxAttempts: 3}))
+	done := make(chan error, 1)
+	go func() {
+		_, err := client.GetStatus()
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		if !errors.Is(err, ErrRateLimited) {
+			t.Errorf("GetStatus() error = %v, want ErrRateLimited", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("GetStatus() did not return")
+	}
+	if got := atomic.LoadInt32(&requests); got != 3 {
+		t.Errorf("%d requests sent, want 3", got)
+	}
+}
@@ -0,0 +1,54 @@
+package battleritego
+
+import "net/http"
+
+// ResponseInfo contains information about an API response beyond its data.
+type ResponseInfo struct {
+	// URL is the URL that was requested.
+	URL string
+	// Links contains the pagination links of the response, such as "next" and "prev".
+	Links Links
+	// Meta contains the meta information of the response, such as result counts.
+	Meta Meta
+	// RateLimit is the rate limit reported by the response headers.
+	RateLimit RateLimit
+	// RequestID is the X-Request-Id header of the response, useful when reporting issues.
+	RequestID string
+}
+
+// MatchesPage contains a page of matches and information about its response.
+// See GetMatchesPage in client.go.
+type MatchesPage struct {
+	Matches []Match
+	ResponseInfo
+}
+
+// PlayersPage contains a page of players and information about its response.
+// See GetPlayersPage in client.go.
+type PlayersPage struct {
+	Players []Player
+	ResponseInfo
+}
+
+// TeamsPage contains a page of teams and information about its response.
+// See GetTeamsPage in client.go.
+type TeamsPage struct {
+	Teams []Team
+	ResponseInfo
+}
+
+// newResponseInfo returns the ResponseInfo of a decoded response.
+func newResponseInfo(URL string, res Response, header http.Header) ResponseInfo {
+	return ResponseInfo{
+		URL:       URL,
+		Links:     res.Links,
+		Meta:      res.Meta,
+		RateLimit: rateLimitFromHeader(header),
+		RequestID: header.Get("X-Request-Id"),
+	}
+}
+
+// NextURL returns the URL of the next page, or an empty string on the last page.
+func (info ResponseInfo) NextURL() string {
+	return info.Links["next"]
+}
@@ -0,0 +1,114 @@
+package battleritego
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy controls how failed requests are retried.
+// The zero RetryPolicy sends every request once.
+type RetryPolicy struct {
+	// MaxAttempts is the number of times a request is sent, including the first one.
+	MaxAttempts int
+	// MinBackoff is the wait before the first retry, it doubles for each retry after it.
+	MinBackoff time.Duration
+	// MaxBackoff caps the wait between retries, unless the response asks for a longer
+	// one with a Retry-After header.
+	MaxBackoff time.Duration
+	// Retryable reports whether a request should be retried, DefaultRetryable is used if nil.
+	// resp is nil when the request failed without a response, its body is already closed.
+	Retryable func(resp *http.Response, err error) bool
+}
+
+// DefaultRetryPolicy retries network errors, 5xx and 429 responses up to two times.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 3,
+	MinBackoff:  500 * time.Millisecond,
+	MaxBackoff:  30 * time.Second,
+}
+
+// DefaultRetryable reports whether a request is worth retrying, which is the case for
+// network errors, 429 Too Many Requests and 5xx responses.
+// Canceled requests and requests held back by the client's own rate limit are not retried.
+func DefaultRetryable(resp *http.Response, err error) bool {
+	if resp != nil {
+		return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500
+	}
+	if err == nil {
+		return false
+	}
+	return !errors.Is(err, context.Canceled) &&
+		!errors.Is(err, context.DeadlineExceeded) &&
+		!errors.Is(err, ErrRateLimited)
+}
+
+// retryable reports whether the request may be sent again after attempt attempts.
+func (policy RetryPolicy) retryable(attempt int, resp *http.Response, err error) bool {
+	if attempt >= policy.MaxAttempts {
+		return false
+	}
+	if policy.Retryable == nil {
+		return DefaultRetryable(resp, err)
+	}
+	return policy.Retryable(resp, err)
+}
+
+// backoff returns the wait before the next attempt.
+// It uses the Retry-After header of resp if there is one, otherwise an exponential
+// backoff with jitter.
+func (policy RetryPolicy) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			return wait
+		}
+	}
+
+	wait := policy.MinBackoff
+	for i := 1; i < attempt && (policy.MaxBackoff <= 0 || wait < policy.MaxBackoff); i++ {
+		wait *= 2
+	}
+	if policy.MaxBackoff > 0 && wait > policy.MaxBackoff {
+		wait = policy.MaxBackoff
+	}
+	if wait <= 0 {
+		return 0
+	}
+
+	// Wait somewhere between half and all of the backoff so clients don't retry in lockstep.
+	return wait/2 + time.Duration(rand.Int63n(int64(wait/2)+1))
+}
+
+// parseRetryAfter returns the wait of a Retry-After header, given in seconds or as an HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+	return 0, false
+}
+
+// sleepContext waits for d or until ctx is done, whichever comes first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
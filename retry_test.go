@@ -0,0 +1,87 @@
+package battleritego
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRetry(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch atomic.AddInt32(&requests, 1) {
+		case 1:
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+		case 2:
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			w.Write([]byte(testStatus))
+		}
+	}))
+	defer server.Close()
+
+	policy := RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond}
+	client := NewClient("key", WithAPIURL(server.URL), WithRetryPolicy(policy))
+	if _, err := client.GetStatus(); err != nil {
+		t.Fatalf("GetStatus() error = %v", err)
+	}
+	if got := atomic.LoadInt32(&requests); got != 3 {
+		t.Errorf("%d requests sent, want 3", got)
+	}
+
+	atomic.StoreInt32(&requests, 0)
+	policy.MaxAttempts = 2
+	client = NewClient("key", WithAPIURL(server.URL), WithRetryPolicy(policy))
+	if _, err := client.GetStatus(); err == nil {
+		t.Error("GetStatus() returned no error after the last attempt failed")
+	}
+
+	// Without a policy requests are sent once.
+	atomic.StoreInt32(&requests, 0)
+	client = NewClient("key", WithAPIURL(server.URL))
+	client.GetStatus()
+	if got := atomic.LoadInt32(&requests); got != 1 {
+		t.Errorf("%d requests sent without a RetryPolicy, want 1", got)
+	}
+}
+
+func TestRetryContextCanceled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "10")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	client := NewClient("key", WithAPIURL(server.URL), WithRetryPolicy(RetryPolicy{MaxAttempts: 3}))
+	_, err := client.GetStatusContext(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("GetStatusContext() error = %v, want context.DeadlineExceeded", err)
+	}
+	apiErr := &APIError{}
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("GetStatusContext() error = %v, want the last APIError", err)
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 5, MinBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"2"}}}
+	if got := policy.backoff(1, resp); got != 2*time.Second {
+		t.Errorf("backoff() with Retry-After: 2 = %s, want 2s", got)
+	}
+
+	for attempt := 1; attempt <= 6; attempt++ {
+		got := policy.backoff(attempt, nil)
+		if got < policy.MinBackoff/2 || got > policy.MaxBackoff {
+			t.Errorf("backoff(%d) = %s, outside [%s, %s]", attempt, got, policy.MinBackoff/2, policy.MaxBackoff)
+		}
+	}
+}
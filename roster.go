@@ -17,25 +17,51 @@ type Roster struct {
 	Team         interface{}
 }
 
-// SingleRosterFromData returns a single Roster from data.
-func SingleRosterFromData(data map[string]interface{}) Roster {
-	attributes := data["attributes"].(map[string]interface{})
-	stats := attributes["stats"].(map[string]interface{})
-	relationships := data["relationships"].(map[string]interface{})
-	participants := relationships["participants"].(map[string]interface{})
-	partData := participants["data"]
-	team := relationships["team"].(map[string]interface{})
-	teamData := team["data"]
-
-	won, _ := strconv.ParseBool(attributes["won"].(string))
+// rosterAttributes are the attributes of a roster resource.
+type rosterAttributes struct {
+	ShardID string `json:"shardId"`
+	Won     string `json:"won"`
+	Stats   struct {
+		Score truncatedInt `json:"score"`
+	} `json:"stats"`
+}
+
+// SingleRosterFromResource returns a single Roster from a resource.
+func SingleRosterFromResource(resource Resource) (Roster, error) {
+	attributes := rosterAttributes{}
+	if err := resource.decodeAttributes(&attributes); err != nil {
+		return Roster{}, err
+	}
+
+	partData, err := toGeneric(resource.Relationships["participants"].Data)
+	if err != nil {
+		return Roster{}, err
+	}
+	teamData, err := toGeneric(resource.Relationships["team"].Data)
+	if err != nil {
+		return Roster{}, err
+	}
+
+	won, _ := strconv.ParseBool(attributes.Won)
 
 	return Roster{
-		Type:         data["type"].(string),
-		ID:           data["id"].(string),
-		ShardID:      attributes["shardId"].(string),
+		Type:         resource.Type,
+		ID:           resource.ID,
+		ShardID:      attributes.ShardID,
 		Won:          won,
-		Score:        int(stats["score"].(float64)),
+		Score:        int(attributes.Stats.Score),
 		Participants: partData,
 		Team:         teamData,
+	}, nil
+}
+
+// SingleRosterFromData returns a single Roster from the decoded JSON of a resource.
+//
+// Deprecated: use SingleRosterFromResource.
+func SingleRosterFromData(data map[string]interface{}) (Roster, error) {
+	resource, err := resourceFromData(data)
+	if err != nil {
+		return Roster{}, err
 	}
+	return SingleRosterFromResource(resource)
 }
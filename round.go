@@ -11,16 +11,38 @@ type Round struct {
 	Ordinal     int
 }
 
-// SingleRoundFromData returns a single Round from data.
-func SingleRoundFromData(data map[string]interface{}) Round {
-	attributes := data["attributes"].(map[string]interface{})
-	stats := attributes["stats"].(map[string]interface{})
+// roundAttributes are the attributes of a round resource.
+type roundAttributes struct {
+	Duration truncatedInt `json:"duration"`
+	Ordinal  truncatedInt `json:"ordinal"`
+	Stats    struct {
+		WinningTeam truncatedInt `json:"winningTeam"`
+	} `json:"stats"`
+}
+
+// SingleRoundFromResource returns a single Round from a resource.
+func SingleRoundFromResource(resource Resource) (Round, error) {
+	attributes := roundAttributes{}
+	if err := resource.decodeAttributes(&attributes); err != nil {
+		return Round{}, err
+	}
 
 	return Round{
-		Type:        data["type"].(string),
-		ID:          data["id"].(string),
-		WinningTeam: int(stats["winningTeam"].(float64)),
-		Duration:    int(attributes["duration"].(float64)),
-		Ordinal:     int(attributes["ordinal"].(float64)),
+		Type:        resource.Type,
+		ID:          resource.ID,
+		WinningTeam: int(attributes.Stats.WinningTeam),
+		Duration:    int(attributes.Duration),
+		Ordinal:     int(attributes.Ordinal),
+	}, nil
+}
+
+// SingleRoundFromData returns a single Round from the decoded JSON of a resource.
+//
+// Deprecated: use SingleRoundFromResource.
+func SingleRoundFromData(data map[string]interface{}) (Round, error) {
+	resource, err := resourceFromData(data)
+	if err != nil {
+		return Round{}, err
 	}
+	return SingleRoundFromResource(resource)
 }
@@ -9,3 +9,9 @@ type Status struct {
 	Release string
 	Version string
 }
+
+// statusAttributes are the attributes of a status resource.
+type statusAttributes struct {
+	ReleasedAt string `json:"releasedAt"`
+	Version    string `json:"version"`
+}
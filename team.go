@@ -29,60 +29,109 @@ type Team struct {
 // TeamFilter contains parameters for filtering teams using
 // GetTeamsFiltered.
 // Note that both parameters are required.
+// GetTeamsForPlayers in team_lookup.go requests several seasons and any number of players.
 // See client.go for more information.
 type TeamFilter struct {
 	Season    int
 	PlayerIDs []int
 }
 
-// SingleTeamFromData returns a single Team from data.
-func SingleTeamFromData(data map[string]interface{}) Team {
-	attributes := data["attributes"].(map[string]interface{})
-	relationships := data["relationships"].(map[string]interface{})
-	stats := attributes["stats"].(map[string]interface{})
-	assets := relationships["assets"].(map[string]interface{})
+// teamAttributes are the attributes of a team resource.
+type teamAttributes struct {
+	Name    string `json:"name"`
+	ShardID string `json:"shardId"`
+	TitleID string `json:"titleId"`
+	Stats   struct {
+		PlacementGamesLeft truncatedInt `json:"placementGamesLeft"`
+		Avatar             truncatedInt `json:"avatar"`
+		Wins               truncatedInt `json:"wins"`
+		Losses             truncatedInt `json:"losses"`
+		Members            []string     `json:"members"`
+		Division           truncatedInt `json:"division"`
+		DivisionRating     truncatedInt `json:"divisionRating"`
+		TopDivision        truncatedInt `json:"topDivision"`
+		TopDivisionRating  truncatedInt `json:"topDivisionRating"`
+		League             truncatedInt `json:"league"`
+		TopLeague          truncatedInt `json:"topLeague"`
+	} `json:"stats"`
+}
+
+// SingleTeamFromResource returns a single Team from a resource.
+func SingleTeamFromResource(resource Resource) (Team, error) {
+	attributes := teamAttributes{}
+	if err := resource.decodeAttributes(&attributes); err != nil {
+		return Team{}, err
+	}
 
-	id, _ := strconv.Atoi(data["id"].(string))
+	assets, err := toGeneric(resource.Relationships["assets"])
+	if err != nil {
+		return Team{}, err
+	}
+	assetsMap, _ := assets.(map[string]interface{})
+
+	id, _ := strconv.Atoi(resource.ID)
+	stats := attributes.Stats
 
 	members := []int{}
-	for _, user := range stats["members"].([]interface{}) {
-		str, _ := strconv.Atoi(user.(string))
-		members = append(members, str)
+	for _, user := range stats.Members {
+		member, _ := strconv.Atoi(user)
+		members = append(members, member)
 	}
 
 	return Team{
-		Type:               data["type"].(string),
+		Type:               resource.Type,
 		ID:                 id,
-		Name:               attributes["name"].(string),
-		ShardID:            attributes["shardId"].(string),
-		TitleID:            attributes["titleId"].(string),
-		PlacementGamesLeft: int(stats["placementGamesLeft"].(float64)),
-		Avatar:             int(stats["avatar"].(float64)),
-		Wins:               int(stats["wins"].(float64)),
-		Losses:             int(stats["losses"].(float64)),
+		Name:               attributes.Name,
+		ShardID:            attributes.ShardID,
+		TitleID:            attributes.TitleID,
+		PlacementGamesLeft: int(stats.PlacementGamesLeft),
+		Avatar:             int(stats.Avatar),
+		Wins:               int(stats.Wins),
+		Losses:             int(stats.Losses),
 		Members:            members,
-		Division:           int(stats["division"].(float64)),
-		DivisionRating:     int(stats["divisionRating"].(float64)),
-		TopDivision:        int(stats["topDivision"].(float64)),
-		TopDivisionRating:  int(stats["topDivisionRating"].(float64)),
-		League:             int(stats["league"].(float64)),
-		TopLeague:          int(stats["topLeague"].(float64)),
-		Assets:             assets,
+		Division:           int(stats.Division),
+		DivisionRating:     int(stats.DivisionRating),
+		TopDivision:        int(stats.TopDivision),
+		TopDivisionRating:  int(stats.TopDivisionRating),
+		League:             int(stats.League),
+		TopLeague:          int(stats.TopLeague),
+		Assets:             assetsMap,
+	}, nil
+}
+
+// SingleTeamFromData returns a single Team from the decoded JSON of a resource.
+//
+// Deprecated: use SingleTeamFromResource.
+func SingleTeamFromData(data map[string]interface{}) (Team, error) {
+	resource, err := resourceFromData(data)
+	if err != nil {
+		return Team{}, err
 	}
+	return SingleTeamFromResource(resource)
 }
 
-// MultiTeamsFromData returns a slice of teams from the data.
-func MultiTeamsFromData(data []interface{}) ([]Team, error) {
+// MultiTeamsFromResources returns a slice of teams from the resources.
+func MultiTeamsFromResources(resources []Resource) ([]Team, error) {
 	teamData := []Team{}
 
-	if len(data) < 1 {
-		return teamData, nil
-	}
-
-	for _, tempData := range data {
-		team := SingleTeamFromData(tempData.(map[string]interface{}))
+	for _, resource := range resources {
+		team, err := SingleTeamFromResource(resource)
+		if err != nil {
+			return teamData, err
+		}
 		teamData = append(teamData, team)
 	}
 
 	return teamData, nil
 }
+
+// MultiTeamsFromData returns a slice of teams from the decoded JSON of an array of resources.
+//
+// Deprecated: use MultiTeamsFromResources.
+func MultiTeamsFromData(data []interface{}) ([]Team, error) {
+	resources, err := resourcesFromData(data)
+	if err != nil {
+		return []Team{}, err
+	}
+	return MultiTeamsFromResources(resources)
+}
@@ -0,0 +1,100 @@
+package battleritego
+
+import "context"
+
+// MaxTeamPlayerIDsPerRequest is the most player IDs GetTeamsForPlayers sends in a single
+// teams request. The API documentation doesn't state a limit for the teams endpoint, so
+// this is a conservative value kept below the limit of the players endpoint.
+const MaxTeamPlayerIDsPerRequest = 5
+
+// SeasonTeam is a Team along with the season its stats belong to.
+type SeasonTeam struct {
+	Season int
+	Team
+}
+
+// TeamLookup is the result of GetTeamsForPlayers.
+// Teams holds every team found once per season, in the order they were requested.
+// ByPlayer holds the teams of each requested player, it has an entry for every player,
+// empty for players without a team.
+type TeamLookup struct {
+	Teams    []SeasonTeam
+	ByPlayer map[int][]SeasonTeam
+}
+
+// defaultTeamLookupConcurrency is how many teams requests GetTeamsForPlayers runs at once.
+const defaultTeamLookupConcurrency = 4
+
+// GetTeamsForPlayers returns the teams of playerIDs in every season of seasons.
+// A request is made per season for every MaxTeamPlayerIDsPerRequest players, running a few
+// of them at once, see GetTeamsForPlayersConcurrently to choose how many.
+// Teams returned by more than one request are merged by ID. As the API reports the stats
+// of a team per season, a team found in several seasons is kept once for each of them.
+// If a request fails, the teams found by the others are returned with its error.
+func (client Client) GetTeamsForPlayers(seasons []int, playerIDs []int) (TeamLookup, error) {
+	return client.GetTeamsForPlayersConcurrentlyContext(context.Background(), seasons, playerIDs, defaultTeamLookupConcurrency)
+}
+
+// GetTeamsForPlayersContext is like GetTeamsForPlayers but uses ctx for the requests.
+func (client Client) GetTeamsForPlayersContext(ctx context.Context, seasons []int, playerIDs []int) (TeamLookup, error) {
+	return client.GetTeamsForPlayersConcurrentlyContext(ctx, seasons, playerIDs, defaultTeamLookupConcurrency)
+}
+
+// GetTeamsForPlayersConcurrently is like GetTeamsForPlayers but runs up to concurrency
+// requests at once, or one at a time if concurrency is below one.
+func (client Client) GetTeamsForPlayersConcurrently(seasons []int, playerIDs []int, concurrency int) (TeamLookup, error) {
+	return client.GetTeamsForPlayersConcurrentlyContext(context.Background(), seasons, playerIDs, concurrency)
+}
+
+// GetTeamsForPlayersConcurrentlyContext is like GetTeamsForPlayersConcurrently but uses ctx
+// for the requests.
+func (client Client) GetTeamsForPlayersConcurrentlyContext(ctx context.Context, seasons []int, playerIDs []int, concurrency int) (TeamLookup, error) {
+	playerIDs = uniqueInts(playerIDs)
+
+	filters := []TeamFilter{}
+	for _, season := range uniqueInts(seasons) {
+		for _, chunk := range chunkInts(playerIDs, MaxTeamPlayerIDsPerRequest) {
+			filters = append(filters, TeamFilter{Season: season, PlayerIDs: chunk})
+		}
+	}
+
+	results := make([][]Team, len(filters))
+	err := forEachConcurrently(ctx, len(filters), concurrency, func(ctx context.Context, i int) error {
+		teams, err := client.GetTeamsFilteredContext(ctx, filters[i])
+		results[i] = teams
+		return err
+	})
+
+	lookup := TeamLookup{
+		Teams:    []SeasonTeam{},
+		ByPlayer: map[int][]SeasonTeam{},
+	}
+	for _, id := range playerIDs {
+		lookup.ByPlayer[id] = []SeasonTeam{}
+	}
+
+	type teamKey struct {
+		season int
+		id     int
+	}
+	seen := map[teamKey]bool{}
+	for i, teams := range results {
+		for _, team := range teams {
+			key := teamKey{filters[i].Season, team.ID}
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+
+			seasonTeam := SeasonTeam{Season: filters[i].Season, Team: team}
+			lookup.Teams = append(lookup.Teams, seasonTeam)
+			for _, member := range team.Members {
+				if teams, ok := lookup.ByPlayer[member]; ok {
+					lookup.ByPlayer[member] = append(teams, seasonTeam)
+				}
+			}
+		}
+	}
+
+	return lookup, err
+}
@@ -0,0 +1,57 @@
+package battleritego
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestGetTeamsForPlayers(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		ids := strings.Split(r.URL.Query().Get("filter[playerIds]"), ",")
+		if len(ids) > MaxTeamPlayerIDsPerRequest {
+			t.Errorf("request for %d players", len(ids))
+		}
+
+		// Every player has a solo team, and players 1 and 7 share team 100.
+		data := []string{`{"type":"team","id":"100","attributes":{"name":"shared","stats":{"members":["1","7"]}}}`}
+		for _, id := range ids {
+			data = append(data, fmt.Sprintf(`{"type":"team","id":"%s","attributes":{"name":"solo","stats":{"members":["%s"]}}}`, id, id))
+		}
+		fmt.Fprintf(w, `{"data":[%s]}`, strings.Join(data, ","))
+	}))
+	defer server.Close()
+
+	client := NewClient("key", WithAPIURL(server.URL))
+	lookups := map[string]func() (TeamLookup, error){
+		"GetTeamsForPlayers": func() (TeamLookup, error) {
+			return client.GetTeamsForPlayers([]int{5, 6, 5}, []int{1, 2, 3, 4, 5, 6, 7})
+		},
+		"GetTeamsForPlayersConcurrently": func() (TeamLookup, error) {
+			return client.GetTeamsForPlayersConcurrently([]int{5, 6, 5}, []int{1, 2, 3, 4, 5, 6, 7}, 2)
+		},
+	}
+	for name, lookupTeams := range lookups {
+		atomic.StoreInt32(&requests, 0)
+		lookup, err := lookupTeams()
+		if err != nil {
+			t.Fatalf("%s() error = %v", name, err)
+		}
+
+		if got := atomic.LoadInt32(&requests); got != 4 {
+			t.Errorf("%s: %d requests, want 4", name, got)
+		}
+		if len(lookup.Teams) != 16 {
+			t.Errorf("%s: found %d teams, want 16", name, len(lookup.Teams))
+		}
+		if len(lookup.ByPlayer[1]) != 4 || len(lookup.ByPlayer[7]) != 4 || len(lookup.ByPlayer[2]) != 2 {
+			t.Errorf("%s: ByPlayer = %d, %d, %d teams for players 1, 7 and 2, want 4, 4 and 2", name,
+				len(lookup.ByPlayer[1]), len(lookup.ByPlayer[7]), len(lookup.ByPlayer[2]))
+		}
+	}
+}
@@ -13,6 +13,53 @@ type Telemetry struct {
 	ServerShutdown      ServerShutdown
 	RoundFinishedEvents []RoundFinishedEvent
 	MatchFinishedEvent  MatchFinishedEvent
+	RawEvents           []RawTelemetryEvent
+	CustomEvents        []TelemetryEvent
+}
+
+// newTelemetry returns a Telemetry with empty event slices.
+func newTelemetry() Telemetry {
+	return Telemetry{
+		RoundEvents:         []RoundEvent{},
+		UserRoundSpells:     []UserRoundSpell{},
+		DeathEvents:         []DeathEvent{},
+		MatchReservedUsers:  []MatchReservedUser{},
+		QueueEvents:         []QueueEvent{},
+		TeamUpdateEvents:    []TeamUpdateEvent{},
+		RoundFinishedEvents: []RoundFinishedEvent{},
+		RawEvents:           []RawTelemetryEvent{},
+		CustomEvents:        []TelemetryEvent{},
+	}
+}
+
+// add stores event in the field of its type.
+func (telemetry *Telemetry) add(event TelemetryEvent) {
+	switch event := event.(type) {
+	case MatchStart:
+		telemetry.MatchStart = event
+	case RoundEvent:
+		telemetry.RoundEvents = append(telemetry.RoundEvents, event)
+	case UserRoundSpell:
+		telemetry.UserRoundSpells = append(telemetry.UserRoundSpells, event)
+	case DeathEvent:
+		telemetry.DeathEvents = append(telemetry.DeathEvents, event)
+	case MatchReservedUser:
+		telemetry.MatchReservedUsers = append(telemetry.MatchReservedUsers, event)
+	case QueueEvent:
+		telemetry.QueueEvents = append(telemetry.QueueEvents, event)
+	case TeamUpdateEvent:
+		telemetry.TeamUpdateEvents = append(telemetry.TeamUpdateEvents, event)
+	case ServerShutdown:
+		telemetry.ServerShutdown = event
+	case RoundFinishedEvent:
+		telemetry.RoundFinishedEvents = append(telemetry.RoundFinishedEvents, event)
+	case MatchFinishedEvent:
+		telemetry.MatchFinishedEvent = event
+	case RawTelemetryEvent:
+		telemetry.RawEvents = append(telemetry.RawEvents, event)
+	default:
+		telemetry.CustomEvents = append(telemetry.CustomEvents, event)
+	}
 }
 
 // MatchStart is a telemetry event containing information at a matches start.
@@ -31,22 +78,23 @@ type MatchStart struct {
 }
 
 // MatchStartFromData returns a MatchStart from data.
-func MatchStartFromData(data map[string]interface{}) MatchStart {
-	dataObject := data["dataObject"].(map[string]interface{})
+func MatchStartFromData(data map[string]interface{}) (MatchStart, error) {
+	f := newFieldReader("match start", data)
+	dataObject := f.object("dataObject")
 
 	return MatchStart{
-		Type:            data["type"].(string),
-		Cursor:          int(data["cursor"].(float64)),
-		Time:            int(dataObject["time"].(float64)),
-		MatchID:         dataObject["matchID"].(string),
-		ExternalMatchID: dataObject["externalMatchID"].(string),
-		Version:         dataObject["version"].(string),
-		EventType:       dataObject["type"].(string),
-		GameMode:        int(dataObject["gameMode"].(float64)),
-		MapID:           dataObject["mapID"].(string),
-		TeamSize:        int(dataObject["teamSize"].(float64)),
-		Region:          dataObject["region"].(string),
-	}
+		Type:            f.string("type"),
+		Cursor:          f.int("cursor"),
+		Time:            dataObject.int("time"),
+		MatchID:         dataObject.string("matchID"),
+		ExternalMatchID: dataObject.string("externalMatchID"),
+		Version:         dataObject.string("version"),
+		EventType:       dataObject.string("type"),
+		GameMode:        dataObject.int("gameMode"),
+		MapID:           dataObject.string("mapID"),
+		TeamSize:        dataObject.int("teamSize"),
+		Region:          dataObject.string("region"),
+	}, f.err()
 }
 
 // RoundEvent is a telemetry event containing information about various events during a round.
@@ -65,22 +113,23 @@ type RoundEvent struct {
 }
 
 // RoundEventFromData returns a RoundEvent from data.
-func RoundEventFromData(data map[string]interface{}) RoundEvent {
-	dataObject := data["dataObject"].(map[string]interface{})
+func RoundEventFromData(data map[string]interface{}) (RoundEvent, error) {
+	f := newFieldReader("round event", data)
+	dataObject := f.object("dataObject")
 
 	return RoundEvent{
-		Type:            data["type"].(string),
-		Cursor:          int(data["cursor"].(float64)),
-		Time:            int(dataObject["time"].(float64)),
-		MatchID:         dataObject["matchID"].(string),
-		ExternalMatchID: dataObject["externalMatchID"].(string),
-		UserID:          dataObject["userID"].(string),
-		Round:           int(dataObject["round"].(float64)),
-		Character:       int(dataObject["character"].(float64)),
-		EventType:       dataObject["type"].(string),
-		Value:           int(dataObject["value"].(float64)),
-		TimeIntoRound:   int(dataObject["timeIntoRound"].(float64)),
-	}
+		Type:            f.string("type"),
+		Cursor:          f.int("cursor"),
+		Time:            dataObject.int("time"),
+		MatchID:         dataObject.string("matchID"),
+		ExternalMatchID: dataObject.string("externalMatchID"),
+		UserID:          dataObject.string("userID"),
+		Round:           dataObject.int("round"),
+		Character:       dataObject.int("character"),
+		EventType:       dataObject.string("type"),
+		Value:           dataObject.int("value"),
+		TimeIntoRound:   dataObject.int("timeIntoRound"),
+	}, f.err()
 }
 
 // UserRoundSpell is a telemetry event containing information about a characters ability use.
@@ -99,22 +148,23 @@ type UserRoundSpell struct {
 }
 
 // UserRoundSpellFromData returns a UserRoundSpell from data.
-func UserRoundSpellFromData(data map[string]interface{}) UserRoundSpell {
-	dataObject := data["dataObject"].(map[string]interface{})
+func UserRoundSpellFromData(data map[string]interface{}) (UserRoundSpell, error) {
+	f := newFieldReader("user round spell", data)
+	dataObject := f.object("dataObject")
 
 	return UserRoundSpell{
-		Type:         data["type"].(string),
-		Cursor:       int(data["cursor"].(float64)),
-		Time:         int(dataObject["time"].(float64)),
-		AccountID:    dataObject["accountId"].(string),
-		MatchID:      dataObject["matchId"].(string),
-		Round:        int(dataObject["round"].(float64)),
-		Character:    int(dataObject["character"].(float64)),
-		TypeID:       int(dataObject["typeId"].(float64)),
-		SourceTypeID: int(dataObject["sourceTypeId"].(float64)),
-		ScoreType:    dataObject["scoreType"].(string),
-		Value:        int(dataObject["value"].(float64)),
-	}
+		Type:         f.string("type"),
+		Cursor:       f.int("cursor"),
+		Time:         dataObject.int("time"),
+		AccountID:    dataObject.string("accountId"),
+		MatchID:      dataObject.string("matchId"),
+		Round:        dataObject.int("round"),
+		Character:    dataObject.int("character"),
+		TypeID:       dataObject.int("typeId"),
+		SourceTypeID: dataObject.int("sourceTypeId"),
+		ScoreType:    dataObject.string("scoreType"),
+		Value:        dataObject.int("value"),
+	}, f.err()
 }
 
 // DeathEvent is a telemetry event containing information about a characters death.
@@ -128,17 +178,18 @@ type DeathEvent struct {
 }
 
 // DeathEventFromData returns a single DeathEvent from data.
-func DeathEventFromData(data map[string]interface{}) DeathEvent {
-	dataObject := data["dataObject"].(map[string]interface{})
+func DeathEventFromData(data map[string]interface{}) (DeathEvent, error) {
+	f := newFieldReader("death event", data)
+	dataObject := f.object("dataObject")
 
 	return DeathEvent{
-		Type:            data["type"].(string),
-		Cursor:          int(data["cursor"].(float64)),
-		Time:            int(dataObject["time"].(float64)),
-		MatchID:         dataObject["matchID"].(string),
-		ExternalMatchID: dataObject["externalMatchID"].(string),
-		UserID:          dataObject["userID"].(string),
-	}
+		Type:            f.string("type"),
+		Cursor:          f.int("cursor"),
+		Time:            dataObject.int("time"),
+		MatchID:         dataObject.string("matchID"),
+		ExternalMatchID: dataObject.string("externalMatchID"),
+		UserID:          dataObject.string("userID"),
+	}, f.err()
 }
 
 // MatchReservedUser is a telemetry event containing information about a match user.
@@ -167,32 +218,33 @@ type MatchReservedUser struct {
 }
 
 // MatchReservedUserFromData returns a single MatchReservedUser from data.
-func MatchReservedUserFromData(data map[string]interface{}) MatchReservedUser {
-	dataObject := data["dataObject"].(map[string]interface{})
+func MatchReservedUserFromData(data map[string]interface{}) (MatchReservedUser, error) {
+	f := newFieldReader("match reserved user", data)
+	dataObject := f.object("dataObject")
 
 	return MatchReservedUser{
-		Type:                data["type"].(string),
-		Cursor:              int(data["cursor"].(float64)),
-		Time:                int(dataObject["time"].(float64)),
-		AccountID:           dataObject["accountId"].(string),
-		MatchID:             dataObject["matchId"].(string),
-		ServerType:          dataObject["serverType"].(string),
-		CharacterLevel:      int(dataObject["characterLevel"].(float64)),
-		TeamID:              dataObject["teamId"].(string),
-		TotalTimePlayed:     int(dataObject["totalTimePlayed"].(float64)),
-		CharacterTimePlayed: int(dataObject["characterTimePlayed"].(float64)),
-		Character:           int(dataObject["character"].(float64)),
-		Team:                int(dataObject["team"].(float64)),
-		RankingType:         dataObject["rankingType"].(string),
-		Mount:               int(dataObject["mount"].(float64)),
-		Attachment:          int(dataObject["attachment"].(float64)),
-		Outfit:              int(dataObject["outfit"].(float64)),
-		Emote:               int(dataObject["emote"].(float64)),
-		League:              int(dataObject["league"].(float64)),
-		Division:            int(dataObject["division"].(float64)),
-		DivisionRating:      int(dataObject["divisionRating"].(float64)),
-		SeasonID:            int(dataObject["seasonId"].(float64)),
-	}
+		Type:                f.string("type"),
+		Cursor:              f.int("cursor"),
+		Time:                dataObject.int("time"),
+		AccountID:           dataObject.string("accountId"),
+		MatchID:             dataObject.string("matchId"),
+		ServerType:          dataObject.string("serverType"),
+		CharacterLevel:      dataObject.int("characterLevel"),
+		TeamID:              dataObject.string("teamId"),
+		TotalTimePlayed:     dataObject.int("totalTimePlayed"),
+		CharacterTimePlayed: dataObject.int("characterTimePlayed"),
+		Character:           dataObject.int("character"),
+		Team:                dataObject.int("team"),
+		RankingType:         dataObject.string("rankingType"),
+		Mount:               dataObject.int("mount"),
+		Attachment:          dataObject.int("attachment"),
+		Outfit:              dataObject.int("outfit"),
+		Emote:               dataObject.int("emote"),
+		League:              dataObject.int("league"),
+		Division:            dataObject.int("division"),
+		DivisionRating:      dataObject.int("divisionRating"),
+		SeasonID:            dataObject.int("seasonId"),
+	}, f.err()
 }
 
 // QueueEvent is a telemetry event containing information about a user's queue.
@@ -227,38 +279,44 @@ type QueueEvent struct {
 }
 
 // QueueEventFromData returns a single QueueEvent from data.
-func QueueEventFromData(data map[string]interface{}) QueueEvent {
-	dataObject := data["dataObject"].(map[string]interface{})
+func QueueEventFromData(data map[string]interface{}) (QueueEvent, error) {
+	f := newFieldReader("queue event", data)
+	dataObject := f.object("dataObject")
 
-	return QueueEvent{
-		Type:                  data["type"].(string),
-		Cursor:                int(data["cursor"].(float64)),
-		Time:                  int(dataObject["time"].(float64)),
-		UserID:                dataObject["userId"].(string),
-		TeamID:                dataObject["teamId"].(string),
-		SessionID:             dataObject["sessionId"].(string),
-		Season:                int(dataObject["season"].(float64)),
-		EventType:             dataObject["eventType"].(string),
-		TimeJoinedQueue:       dataObject["timeJoinedQueue"].(string),
-		TimeInQueue:           dataObject["timeInQueue"].(float64),
-		Character:             int(dataObject["character"].(float64)),
-		CharacterArchetype:    int(dataObject["characterArchetype"].(float64)),
-		QueueTypes:            dataObject["queueTypes"].([]interface{}),
-		LimitMatchmakingRange: dataObject["limitMatchmakingRange"].(bool),
-		RegionSamples:         MultiRegionSamplesFromData(dataObject["regionSamples"].([]interface{})),
-		PreferedRegion:        dataObject["preferredRegion"].(string),
-		RankingType:           dataObject["rankingType"].(string),
-		League:                int(dataObject["league"].(float64)),
-		Division:              int(dataObject["division"].(float64)),
-		DivisionRating:        int(dataObject["divisionRating"].(float64)),
-		TeamSize:              int(dataObject["teamSize"].(float64)),
-		TeamMembers:           dataObject["teamMembers"],
-		PlacementGamesLeft:    int(dataObject["placementGamesLeft"].(float64)),
-		MatchID:               dataObject["matchId"].(string),
-		MatchRegion:           dataObject["matchRegion"].(string),
-		TeamSide:              int(dataObject["teamSide"].(float64)),
-		AutoMatchmaking:       dataObject["autoMatchmaking"].(bool),
+	regionSamples, err := MultiRegionSamplesFromData(dataObject.slice("regionSamples"))
+	if err != nil {
+		return QueueEvent{}, err
 	}
+
+	return QueueEvent{
+		Type:                  f.string("type"),
+		Cursor:                f.int("cursor"),
+		Time:                  dataObject.int("time"),
+		UserID:                dataObject.string("userId"),
+		TeamID:                dataObject.string("teamId"),
+		SessionID:             dataObject.string("sessionId"),
+		Season:                dataObject.int("season"),
+		EventType:             dataObject.string("eventType"),
+		TimeJoinedQueue:       dataObject.string("timeJoinedQueue"),
+		TimeInQueue:           dataObject.float("timeInQueue"),
+		Character:             dataObject.int("character"),
+		CharacterArchetype:    dataObject.int("characterArchetype"),
+		QueueTypes:            dataObject.slice("queueTypes"),
+		LimitMatchmakingRange: dataObject.bool("limitMatchmakingRange"),
+		RegionSamples:         regionSamples,
+		PreferedRegion:        dataObject.string("preferredRegion"),
+		RankingType:           dataObject.string("rankingType"),
+		League:                dataObject.int("league"),
+		Division:              dataObject.int("division"),
+		DivisionRating:        dataObject.int("divisionRating"),
+		TeamSize:              dataObject.int("teamSize"),
+		TeamMembers:           dataObject.value("teamMembers"),
+		PlacementGamesLeft:    dataObject.int("placementGamesLeft"),
+		MatchID:               dataObject.string("matchId"),
+		MatchRegion:           dataObject.string("matchRegion"),
+		TeamSide:              dataObject.int("teamSide"),
+		AutoMatchmaking:       dataObject.bool("autoMatchmaking"),
+	}, f.err()
 }
 
 // RegionSample contains information about a user's region during a QueueEvent.
@@ -269,22 +327,33 @@ type RegionSample struct {
 }
 
 // SingleRegionSampleFromData returns a RegionSample from data.
-func SingleRegionSampleFromData(data map[string]interface{}) RegionSample {
+func SingleRegionSampleFromData(data map[string]interface{}) (RegionSample, error) {
+	f := newFieldReader("region sample", data)
+
 	return RegionSample{
-		Region:    data["region"].(string),
-		LatencyMS: int(data["latencyMS"].(float64)),
-	}
+		Region:    f.string("region"),
+		LatencyMS: f.int("latencyMS"),
+	}, f.err()
 }
 
 // MultiRegionSamplesFromData returns a slice of RegionSamples from data.
-func MultiRegionSamplesFromData(data []interface{}) []RegionSample {
+func MultiRegionSamplesFromData(data []interface{}) ([]RegionSample, error) {
 	regionSamples := []RegionSample{}
 
-	for _, rs := range data {
-		regionSamples = append(regionSamples, SingleRegionSampleFromData(rs.(map[string]interface{})))
+	objs, err := toObjects("region samples", data)
+	if err != nil {
+		return regionSamples, err
+	}
+
+	for _, rs := range objs {
+		regionSample, err := SingleRegionSampleFromData(rs)
+		if err != nil {
+			return regionSamples, err
+		}
+		regionSamples = append(regionSamples, regionSample)
 	}
 
-	return regionSamples
+	return regionSamples, nil
 }
 
 // TeamUpdateEvent is a telemetry event containing information about a team data update.
@@ -315,39 +384,44 @@ type TeamUpdateEvent struct {
 }
 
 // TeamUpdateEventFromData returns a TeamUpdateEvent from data.
-func TeamUpdateEventFromData(data map[string]interface{}) TeamUpdateEvent {
-	dataObject := data["dataObject"].(map[string]interface{})
+func TeamUpdateEventFromData(data map[string]interface{}) (TeamUpdateEvent, error) {
+	f := newFieldReader("team update event", data)
+	dataObject := f.object("dataObject")
 
 	userIDs := []int{}
-	for _, id := range dataObject["userIDs"].([]interface{}) {
-		userIDs = append(userIDs, int(id.(float64)))
+	for _, id := range dataObject.slice("userIDs") {
+		num, ok := id.(float64)
+		if !ok {
+			dataObject.setErr("userIDs", "an array of numbers")
+		}
+		userIDs = append(userIDs, int(num))
 	}
 
 	return TeamUpdateEvent{
-		Type:                   data["type"].(string),
-		Cursor:                 int(data["cursor"].(float64)),
-		Time:                   int(dataObject["time"].(float64)),
-		Season:                 int(dataObject["season"].(float64)),
-		TeamID:                 dataObject["teamID"].(string),
-		MatchID:                dataObject["matchID"].(string),
-		ExternalMatchID:        dataObject["externalMatchID"].(string),
+		Type:                   f.string("type"),
+		Cursor:                 f.int("cursor"),
+		Time:                   dataObject.int("time"),
+		Season:                 dataObject.int("season"),
+		TeamID:                 dataObject.string("teamID"),
+		MatchID:                dataObject.string("matchID"),
+		ExternalMatchID:        dataObject.string("externalMatchID"),
 		UserIDs:                userIDs,
-		Mode:                   dataObject["mode"].(string),
-		League:                 int(dataObject["league"].(float64)),
-		PrevLeague:             int(dataObject["prevLeague"].(float64)),
-		PrevDivision:           int(dataObject["prevDivision"].(float64)),
-		Division:               int(dataObject["division"].(float64)),
-		PrevDivisionRating:     int(dataObject["prevDivisionRating"].(float64)),
-		DivisionRating:         int(dataObject["divisionRating"].(float64)),
-		PrevWins:               int(dataObject["prevWins"].(float64)),
-		Wins:                   int(dataObject["wins"].(float64)),
-		PrevLosses:             int(dataObject["prevLosses"].(float64)),
-		Losses:                 int(dataObject["losses"].(float64)),
-		RankingChangeType:      dataObject["rankingChangeType"].(string),
-		PrevPlacementGamesLeft: int(dataObject["prevPlacementGamesLeft"].(float64)),
-		PlacementGamesLeft:     int(dataObject["placementGamesLeft"].(float64)),
-		MatchRegion:            dataObject["matchRegion"].(string),
-	}
+		Mode:                   dataObject.string("mode"),
+		League:                 dataObject.int("league"),
+		PrevLeague:             dataObject.int("prevLeague"),
+		PrevDivision:           dataObject.int("prevDivision"),
+		Division:               dataObject.int("division"),
+		PrevDivisionRating:     dataObject.int("prevDivisionRating"),
+		DivisionRating:         dataObject.int("divisionRating"),
+		PrevWins:               dataObject.int("prevWins"),
+		Wins:                   dataObject.int("wins"),
+		PrevLosses:             dataObject.int("prevLosses"),
+		Losses:                 dataObject.int("losses"),
+		RankingChangeType:      dataObject.string("rankingChangeType"),
+		PrevPlacementGamesLeft: dataObject.int("prevPlacementGamesLeft"),
+		PlacementGamesLeft:     dataObject.int("placementGamesLeft"),
+		MatchRegion:            dataObject.string("matchRegion"),
+	}, f.err()
 }
 
 // ServerShutdown is a telemetry event containing information about a battlerite server's closing.
@@ -362,18 +436,19 @@ type ServerShutdown struct {
 }
 
 // ServerShutdownFromData returns a ServerShutdown from data.
-func ServerShutdownFromData(data map[string]interface{}) ServerShutdown {
-	dataObject := data["dataObject"].(map[string]interface{})
+func ServerShutdownFromData(data map[string]interface{}) (ServerShutdown, error) {
+	f := newFieldReader("server shutdown", data)
+	dataObject := f.object("dataObject")
 
 	return ServerShutdown{
-		Type:            data["type"].(string),
-		Cursor:          int(data["cursor"].(float64)),
-		Time:            int(dataObject["time"].(float64)),
-		MatchID:         dataObject["matchID"].(string),
-		ExternalMatchID: dataObject["externalMatchID"].(string),
-		MatchTime:       int(dataObject["matchTime"].(float64)),
-		Reason:          dataObject["reason"].(string),
-	}
+		Type:            f.string("type"),
+		Cursor:          f.int("cursor"),
+		Time:            dataObject.int("time"),
+		MatchID:         dataObject.string("matchID"),
+		ExternalMatchID: dataObject.string("externalMatchID"),
+		MatchTime:       dataObject.int("matchTime"),
+		Reason:          dataObject.string("reason"),
+	}, f.err()
 }
 
 // RoundFinishedEvent is a telemetry event containing information about the end of a round.
@@ -390,20 +465,26 @@ type RoundFinishedEvent struct {
 }
 
 // RoundFinishedEventFromData returns a RoundFinishedEvent from data.
-func RoundFinishedEventFromData(data map[string]interface{}) RoundFinishedEvent {
-	dataObject := data["dataObject"].(map[string]interface{})
+func RoundFinishedEventFromData(data map[string]interface{}) (RoundFinishedEvent, error) {
+	f := newFieldReader("round finished event", data)
+	dataObject := f.object("dataObject")
 
-	return RoundFinishedEvent{
-		Type:            data["type"].(string),
-		Cursor:          int(data["cursor"].(float64)),
-		Time:            int(dataObject["time"].(float64)),
-		MatchID:         dataObject["matchID"].(string),
-		ExternalMatchID: dataObject["externalMatchID"].(string),
-		Round:           int(dataObject["round"].(float64)),
-		RoundLength:     int(dataObject["roundLength"].(float64)),
-		WinningTeam:     int(dataObject["winningTeam"].(float64)),
-		PlayerStats:     MultiPlayerStatsFromData(dataObject["playerStats"].([]interface{})),
+	playerStats, err := MultiPlayerStatsFromData(dataObject.slice("playerStats"))
+	if err != nil {
+		return RoundFinishedEvent{}, err
 	}
+
+	return RoundFinishedEvent{
+		Type:            f.string("type"),
+		Cursor:          f.int("cursor"),
+		Time:            dataObject.int("time"),
+		MatchID:         dataObject.string("matchID"),
+		ExternalMatchID: dataObject.string("externalMatchID"),
+		Round:           dataObject.int("round"),
+		RoundLength:     dataObject.int("roundLength"),
+		WinningTeam:     dataObject.int("winningTeam"),
+		PlayerStats:     playerStats,
+	}, f.err()
 }
 
 // PlayerStats contains information about a players stats at the end of a round as part of a RoundFinishedEvent.
@@ -425,34 +506,44 @@ type PlayerStats struct {
 }
 
 // SinglePlayerStatsFromData returns a single PlayerStats from data.
-func SinglePlayerStatsFromData(data map[string]interface{}) PlayerStats {
+func SinglePlayerStatsFromData(data map[string]interface{}) (PlayerStats, error) {
+	f := newFieldReader("player stats", data)
+
 	return PlayerStats{
-		UserID:           data["userID"].(string),
-		Kills:            int(data["kills"].(float64)),
-		Deaths:           int(data["deaths"].(float64)),
-		Score:            int(data["score"].(float64)),
-		DamageDone:       int(data["damageDone"].(float64)),
-		DamageReceived:   int(data["damageReceived"].(float64)),
-		HealingDone:      int(data["healingDone"].(float64)),
-		HealingReceived:  int(data["healingReceived"].(float64)),
-		DisablesDone:     int(data["disablesDone"].(float64)),
-		DisablesReceived: int(data["disablesReceived"].(float64)),
-		EnergyGained:     int(data["energyGained"].(float64)),
-		EnergyUsed:       int(data["energyUsed"].(float64)),
-		TimeAlive:        int(data["timeAlive"].(float64)),
-		AbilityUses:      int(data["abilityUses"].(float64)),
-	}
+		UserID:           f.string("userID"),
+		Kills:            f.int("kills"),
+		Deaths:           f.int("deaths"),
+		Score:            f.int("score"),
+		DamageDone:       f.int("damageDone"),
+		DamageReceived:   f.int("damageReceived"),
+		HealingDone:      f.int("healingDone"),
+		HealingReceived:  f.int("healingReceived"),
+		DisablesDone:     f.int("disablesDone"),
+		DisablesReceived: f.int("disablesReceived"),
+		EnergyGained:     f.int("energyGained"),
+		EnergyUsed:       f.int("energyUsed"),
+		TimeAlive:        f.int("timeAlive"),
+		AbilityUses:      f.int("abilityUses"),
+	}, f.err()
 }
 
 // MultiPlayerStatsFromData returns a slice of PlayerStats from data.
-func MultiPlayerStatsFromData(data []interface{}) []PlayerStats {
+func MultiPlayerStatsFromData(data []interface{}) ([]PlayerStats, error) {
 	playerStats := []PlayerStats{}
 
-	for _, pData := range data {
-		stat := SinglePlayerStatsFromData(pData.(map[string]interface{}))
+	objs, err := toObjects("player stats", data)
+	if err != nil {
+		return playerStats, err
+	}
+
+	for _, pData := range objs {
+		stat, err := SinglePlayerStatsFromData(pData)
+		if err != nil {
+			return playerStats, err
+		}
 		playerStats = append(playerStats, stat)
 	}
-	return playerStats
+	return playerStats, nil
 }
 
 // MatchFinishedEvent is a telemetry event containing information about the end of a match.
@@ -470,19 +561,20 @@ type MatchFinishedEvent struct {
 }
 
 // MatchFinishedEventFromData returns a single MatchFinishedEvent from data.
-func MatchFinishedEventFromData(data map[string]interface{}) MatchFinishedEvent {
-	dataObject := data["dataObject"].(map[string]interface{})
+func MatchFinishedEventFromData(data map[string]interface{}) (MatchFinishedEvent, error) {
+	f := newFieldReader("match finished event", data)
+	dataObject := f.object("dataObject")
 
 	return MatchFinishedEvent{
-		Type:            data["type"].(string),
-		Cursor:          int(data["cursor"].(float64)),
-		Time:            int(dataObject["time"].(float64)),
-		TeamOneScore:    int(dataObject["teamOneScore"].(float64)),
-		TeamTwoScore:    int(dataObject["teamTwoScore"].(float64)),
-		MatchLength:     int(dataObject["matchLength"].(float64)),
-		MatchID:         dataObject["matchID"].(string),
-		ExternalMatchID: dataObject["externalMatchID"].(string),
-		Leavers:         dataObject["leavers"],
-		Region:          dataObject["region"].(string),
-	}
+		Type:            f.string("type"),
+		Cursor:          f.int("cursor"),
+		Time:            dataObject.int("time"),
+		TeamOneScore:    dataObject.int("teamOneScore"),
+		TeamTwoScore:    dataObject.int("teamTwoScore"),
+		MatchLength:     dataObject.int("matchLength"),
+		MatchID:         dataObject.string("matchID"),
+		ExternalMatchID: dataObject.string("externalMatchID"),
+		Leavers:         dataObject.value("leavers"),
+		Region:          dataObject.string("region"),
+	}, f.err()
 }
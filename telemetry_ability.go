@@ -0,0 +1,88 @@
+package battleritego
+
+import "sort"
+
+// SpellNames maps the TypeID of a UserRoundSpell to the name of the spell.
+// The API doesn't provide names, so the mapping has to come from the game data.
+type SpellNames map[int]string
+
+// AbilityUsage aggregates the UserRoundSpells of a single spell of a player.
+// Scores holds the summed Value of each ScoreType, such as damage or healing done.
+// Round is 0 for usage aggregated over every round of the match.
+// Name is only set if the TypeID was found in the SpellNames given.
+type AbilityUsage struct {
+	UserID    string
+	Character int
+	Round     int
+	TypeID    int
+	Name      string
+	Events    int
+	Scores    map[string]int
+}
+
+// abilityKey identifies the AbilityUsage a UserRoundSpell is aggregated into.
+type abilityKey struct {
+	userID    string
+	character int
+	round     int
+	typeID    int
+}
+
+// AbilityUsage returns the spell usage of every player, aggregated per player, character,
+// round and spell. names may be nil. Usage is ordered by player, round and TypeID.
+func (telemetry Telemetry) AbilityUsage(names SpellNames) []AbilityUsage {
+	return telemetry.abilityUsage(names, true)
+}
+
+// AbilityTotals is like AbilityUsage but aggregates the spell usage over every round.
+func (telemetry Telemetry) AbilityTotals(names SpellNames) []AbilityUsage {
+	return telemetry.abilityUsage(names, false)
+}
+
+// abilityUsage aggregates the UserRoundSpells, per round if perRound is true.
+func (telemetry Telemetry) abilityUsage(names SpellNames, perRound bool) []AbilityUsage {
+	usage := map[abilityKey]*AbilityUsage{}
+	keys := []abilityKey{}
+
+	for _, event := range telemetry.UserRoundSpells {
+		key := abilityKey{event.AccountID, event.Character, 0, event.TypeID}
+		if perRound {
+			key.round = event.Round
+		}
+
+		if usage[key] == nil {
+			usage[key] = &AbilityUsage{
+				UserID:    key.userID,
+				Character: key.character,
+				Round:     key.round,
+				TypeID:    key.typeID,
+				Name:      names[key.typeID],
+				Scores:    map[string]int{},
+			}
+			keys = append(keys, key)
+		}
+		usage[key].Events++
+		usage[key].Scores[event.ScoreType] += event.Value
+	}
+
+	sort.SliceStable(keys, func(i, j int) bool {
+		a, b := keys[i], keys[j]
+		if a.userID != b.userID {
+			return a.userID < b.userID
+		}
+		if a.character != b.character {
+			return a.character < b.character
+		}
+		if a.round != b.round {
+			return a.round < b.round
+		}
+		return a.typeID < b.typeID
+	})
+
+	result := make([]AbilityUsage, 0, len(keys))
+	for _, key := range keys {
+		result = append(result, *usage[key])
+	}
+
+	return result
+}
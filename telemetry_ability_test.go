@@ -0,0 +1,39 @@
+package battleritego
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAbilityUsage(t *testing.T) {
+	telemetry := newTelemetry()
+	telemetry.UserRoundSpells = []UserRoundSpell{
+		{AccountID: "2", Character: 6, Round: 1, TypeID: 30, ScoreType: "DamageDone", Value: 5},
+		{AccountID: "1", Character: 5, Round: 2, TypeID: 10, ScoreType: "DamageDone", Value: 20},
+		{AccountID: "1", Character: 5, Round: 1, TypeID: 10, ScoreType: "DamageDone", Value: 10},
+		{AccountID: "1", Character: 5, Round: 1, TypeID: 10, ScoreType: "HealingDone", Value: 4},
+		{AccountID: "1", Character: 5, Round: 1, TypeID: 20, ScoreType: "DamageDone", Value: 7},
+	}
+	names := SpellNames{10: "Fireball"}
+
+	usage := telemetry.AbilityUsage(names)
+	want := []AbilityUsage{
+		{UserID: "1", Character: 5, Round: 1, TypeID: 10, Name: "Fireball", Events: 2, Scores: map[string]int{"DamageDone": 10, "HealingDone": 4}},
+		{UserID: "1", Character: 5, Round: 1, TypeID: 20, Events: 1, Scores: map[string]int{"DamageDone": 7}},
+		{UserID: "1", Character: 5, Round: 2, TypeID: 10, Name: "Fireball", Events: 1, Scores: map[string]int{"DamageDone": 20}},
+		{UserID: "2", Character: 6, Round: 1, TypeID: 30, Events: 1, Scores: map[string]int{"DamageDone": 5}},
+	}
+	if !reflect.DeepEqual(usage, want) {
+		t.Errorf("AbilityUsage() = %+v, want %+v", usage, want)
+	}
+
+	totals := telemetry.AbilityTotals(nil)
+	want = []AbilityUsage{
+		{UserID: "1", Character: 5, TypeID: 10, Events: 3, Scores: map[string]int{"DamageDone": 30, "HealingDone": 4}},
+		{UserID: "1", Character: 5, TypeID: 20, Events: 1, Scores: map[string]int{"DamageDone": 7}},
+		{UserID: "2", Character: 6, TypeID: 30, Events: 1, Scores: map[string]int{"DamageDone": 5}},
+	}
+	if !reflect.DeepEqual(totals, want) {
+		t.Errorf("AbilityTotals(nil) = %+v, want %+v", totals, want)
+	}
+}
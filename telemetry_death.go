@@ -0,0 +1,72 @@
+package battleritego
+
+// TimelineDeath is a DeathEvent placed in the timeline of its round.
+// Order is the position of the death within the round, starting at 1. TimeIntoRound is
+// the time in milliseconds from the start of the round, see TelemetryRound.Start.
+// Team and Character come from the MatchReservedUser of the player and are 0 if unknown.
+type TimelineDeath struct {
+	DeathEvent
+	Round         int
+	Order         int
+	TimeIntoRound int
+	Team          int
+	Character     int
+	FirstBlood    bool
+}
+
+// DeathTimeline is the ordered list of deaths of a single round.
+// FirstTeamToLose is the team of the first player to die, or 0 if nobody died or their
+// team is unknown. TeamDeaths counts the deaths of each team.
+type DeathTimeline struct {
+	Round           int
+	Start           int
+	End             int
+	WinningTeam     int
+	Deaths          []TimelineDeath
+	FirstTeamToLose int
+	TeamDeaths      map[int]int
+}
+
+// DeathTimeline returns the deaths of each round ordered by time, with the time into the
+// round of each death, the first blood and the team that lost a member first.
+// Rounds are bounded as described by Rounds.
+func (telemetry Telemetry) DeathTimeline() []DeathTimeline {
+	users := map[string]MatchReservedUser{}
+	for _, user := range telemetry.MatchReservedUsers {
+		users[user.AccountID] = user
+	}
+
+	rounds := telemetry.Rounds()
+	timelines := make([]DeathTimeline, 0, len(rounds))
+	for _, round := range rounds {
+		timeline := DeathTimeline{
+			Round:       round.Round,
+			Start:       round.Start,
+			End:         round.End,
+			WinningTeam: round.WinningTeam,
+			Deaths:      make([]TimelineDeath, 0, len(round.DeathEvents)),
+			TeamDeaths:  map[int]int{},
+		}
+
+		for i, event := range round.DeathEvents {
+			user := users[event.UserID]
+			timeline.Deaths = append(timeline.Deaths, TimelineDeath{
+				DeathEvent:    event,
+				Round:         round.Round,
+				Order:         i + 1,
+				TimeIntoRound: event.Time - round.Start,
+				Team:          user.Team,
+				Character:     user.Character,
+				FirstBlood:    i == 0,
+			})
+			timeline.TeamDeaths[user.Team]++
+		}
+		if len(timeline.Deaths) > 0 {
+			timeline.FirstTeamToLose = timeline.Deaths[0].Team
+		}
+
+		timelines = append(timelines, timeline)
+	}
+
+	return timelines
+}
@@ -0,0 +1,51 @@
+package battleritego
+
+import "testing"
+
+func TestDeathTimeline(t *testing.T) {
+	telemetry := newTelemetry()
+	telemetry.MatchStart = MatchStart{Type: "Structures.MatchStart", Time: 100000}
+	telemetry.MatchReservedUsers = []MatchReservedUser{
+		{AccountID: "1", Team: 1, Character: 5},
+		{AccountID: "2", Team: 2, Character: 6},
+	}
+	// Round 1 lasts 60 seconds after a 10 second countdown, round 2 starts 15 seconds after it.
+	telemetry.RoundFinishedEvents = []RoundFinishedEvent{
+		{Round: 1, Time: 170000, RoundLength: 60, WinningTeam: 1},
+		{Round: 2, Time: 235000, RoundLength: 50, WinningTeam: 2},
+	}
+	telemetry.DeathEvents = []DeathEvent{
+		{UserID: "1", Time: 230000},
+		{UserID: "2", Time: 150000},
+		{UserID: "1", Time: 160000},
+		{UserID: "2", Time: 260000},
+	}
+	// Round 3 is cut short, it starts with its first event rather than when round 2 ended.
+	telemetry.RoundEvents = []RoundEvent{{Round: 3, Time: 250000}}
+
+	timelines := telemetry.DeathTimeline()
+	if len(timelines) != 3 {
+		t.Fatalf("DeathTimeline() returned %d rounds, want 3", len(timelines))
+	}
+
+	first := timelines[0]
+	if first.Start != 110000 || len(first.Deaths) != 2 || first.FirstTeamToLose != 2 {
+		t.Errorf("round 1 = %+v", first)
+	}
+	if death := first.Deaths[0]; death.UserID != "2" || !death.FirstBlood || death.Order != 1 || death.TimeIntoRound != 40000 {
+		t.Errorf("round 1 first death = %+v", death)
+	}
+	if death := first.Deaths[1]; death.FirstBlood || death.Order != 2 || death.TimeIntoRound != 50000 {
+		t.Errorf("round 1 second death = %+v", death)
+	}
+
+	second := timelines[1]
+	if second.Start != 185000 || len(second.Deaths) != 1 || second.Deaths[0].TimeIntoRound != 45000 || second.TeamDeaths[1] != 1 {
+		t.Errorf("round 2 = %+v", second)
+	}
+
+	third := timelines[2]
+	if third.Start != 250000 || len(third.Deaths) != 1 || third.Deaths[0].TimeIntoRound != 10000 {
+		t.Errorf("round 3 = %+v", third)
+	}
+}
@@ -0,0 +1,141 @@
+package battleritego
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+)
+
+// gzipMagic are the first bytes of every gzip stream.
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// TelemetryDecoder reads telemetry events one at a time from a telemetry JSON stream,
+// so only a single event is held in memory at once.
+// Gzip compressed streams are detected and decompressed automatically.
+// Events of types without a registered decoder are returned as a RawTelemetryEvent,
+// see RegisterTelemetryEvent.
+type TelemetryDecoder struct {
+	r       io.Reader
+	dec     *json.Decoder
+	started bool
+	done    bool
+}
+
+// NewTelemetryDecoder returns a TelemetryDecoder reading from r.
+func NewTelemetryDecoder(r io.Reader) *TelemetryDecoder {
+	return &TelemetryDecoder{r: r}
+}
+
+// start sets up the JSON decoder, decompressing the stream if it is gzip compressed,
+// and reads the opening bracket of the event array.
+func (decoder *TelemetryDecoder) start() error {
+	buffered := bufio.NewReader(decoder.r)
+
+	var r io.Reader = buffered
+	if magic, _ := buffered.Peek(len(gzipMagic)); bytes.Equal(magic, gzipMagic) {
+		gz, err := gzip.NewReader(buffered)
+		if err != nil {
+			return err
+		}
+		r = gz
+	}
+	decoder.dec = json.NewDecoder(r)
+
+	tok, err := decoder.dec.Token()
+	if err == io.EOF {
+		// An empty stream is a truncated asset, not an empty telemetry.
+		return io.ErrUnexpectedEOF
+	}
+	if err != nil {
+		return err
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '[' {
+		return fmt.Errorf("battleritego: decoding telemetry: expected an array of events, found %v", tok)
+	}
+
+	decoder.started = true
+	return nil
+}
+
+// Next returns the next telemetry event in the stream, or io.EOF once every event has been read.
+func (decoder *TelemetryDecoder) Next() (TelemetryEvent, error) {
+	if decoder.done {
+		return nil, io.EOF
+	}
+
+	if !decoder.started {
+		if err := decoder.start(); err != nil {
+			return nil, err
+		}
+	}
+
+	if !decoder.dec.More() {
+		// Read the closing bracket so a truncated stream is reported as an error.
+		if _, err := decoder.dec.Token(); err == io.EOF {
+			return nil, io.ErrUnexpectedEOF
+		} else if err != nil {
+			return nil, err
+		}
+		decoder.done = true
+
+		return nil, io.EOF
+	}
+
+	raw := json.RawMessage{}
+	if err := decoder.dec.Decode(&raw); err != nil {
+		return nil, err
+	}
+
+	return telemetryEventFromJSON(raw)
+}
+
+// telemetryEventFromJSON decodes a single raw telemetry event using the decoder registered
+// for its type, falling back to a RawTelemetryEvent.
+func telemetryEventFromJSON(raw json.RawMessage) (TelemetryEvent, error) {
+	data := map[string]interface{}{}
+	if err := json.Unmarshal(raw, &data); err != nil {
+		return nil, err
+	}
+
+	eventType, _ := data["type"].(string)
+	if decode, ok := lookupTelemetryEvent(eventType); ok {
+		return decode(data)
+	}
+
+	return RawTelemetryEventFromData(data, raw)
+}
+
+// DecodeTelemetry reads a whole telemetry JSON stream into a Telemetry.
+// It can be used on telemetry data that was saved to disk, plain or gzip compressed.
+func DecodeTelemetry(r io.Reader) (Telemetry, error) {
+	telemetry := newTelemetry()
+	decoder := NewTelemetryDecoder(r)
+
+	for {
+		event, err := decoder.Next()
+		if err == io.EOF {
+			return telemetry, nil
+		}
+		if err != nil {
+			return Telemetry{}, err
+		}
+
+		telemetry.add(event)
+	}
+}
+
+// LoadTelemetryFile reads the telemetry saved in the file at path into a Telemetry.
+// Both plain .json files and gzip compressed .json.gz files are supported.
+func LoadTelemetryFile(path string) (Telemetry, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return Telemetry{}, err
+	}
+	defer file.Close()
+
+	return DecodeTelemetry(file)
+}
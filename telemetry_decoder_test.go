@@ -0,0 +1,61 @@
+package battleritego
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestDecodeTelemetryTruncated(t *testing.T) {
+	if _, err := DecodeTelemetry(strings.NewReader("")); !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("DecodeTelemetry(\"\") error = %v, want io.ErrUnexpectedEOF", err)
+	}
+
+	for _, body := range []string{"[", testTelemetry[:len(testTelemetry)-1]} {
+		if _, err := DecodeTelemetry(strings.NewReader(body)); err == nil {
+			t.Errorf("DecodeTelemetry(%q) returned no error", body)
+		}
+	}
+
+	telemetry, err := DecodeTelemetry(strings.NewReader(testTelemetry))
+	if err != nil || len(telemetry.DeathEvents) != 1 {
+		t.Errorf("DecodeTelemetry() = %d deaths, %v", len(telemetry.DeathEvents), err)
+	}
+}
+
+func TestDecodeTelemetryGzip(t *testing.T) {
+	compressed := &bytes.Buffer{}
+	gz := gzip.NewWriter(compressed)
+	gz.Write([]byte(testTelemetry))
+	gz.Close()
+
+	telemetry, err := DecodeTelemetry(bytes.NewReader(compressed.Bytes()))
+	if err != nil || len(telemetry.DeathEvents) != 1 || telemetry.DeathEvents[0].UserID != "1" {
+		t.Errorf("DecodeTelemetry() of gzip stream = %+v, %v", telemetry.DeathEvents, err)
+	}
+
+	dir := t.TempDir()
+	for name, data := range map[string][]byte{
+		"telemetry.json":    []byte(testTelemetry),
+		"telemetry.json.gz": compressed.Bytes(),
+	} {
+		path := filepath.Join(dir, name)
+		if err := ioutil.WriteFile(path, data, 0644); err != nil {
+			t.Fatal(err)
+		}
+		telemetry, err := LoadTelemetryFile(path)
+		if err != nil || len(telemetry.DeathEvents) != 1 {
+			t.Errorf("LoadTelemetryFile(%s) = %d deaths, %v", name, len(telemetry.DeathEvents), err)
+		}
+	}
+
+	if _, err := LoadTelemetryFile(filepath.Join(dir, "missing.json")); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("LoadTelemetryFile() of a missing file error = %v, want os.ErrNotExist", err)
+	}
+}
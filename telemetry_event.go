@@ -0,0 +1,284 @@
+package battleritego
+
+import "sort"
+
+// TelemetryEvent is a single decoded telemetry event, such as a MatchStart or a DeathEvent.
+// The methods are prefixed with Get since every event already has fields of the same names.
+type TelemetryEvent interface {
+	// GetType returns the telemetry type of the event, such as "Structures.DeathEvent".
+	GetType() string
+	// GetCursor returns the position of the event in the telemetry data.
+	GetCursor() int
+	// GetTime returns the time of the event.
+	GetTime() int
+	// GetMatchID returns the ID of the match the event belongs to.
+	GetMatchID() string
+}
+
+// GetType returns the telemetry type of the event.
+func (event MatchStart) GetType() string {
+	return event.Type
+}
+
+// GetCursor returns the position of the event in the telemetry data.
+func (event MatchStart) GetCursor() int {
+	return event.Cursor
+}
+
+// GetTime returns the time of the event.
+func (event MatchStart) GetTime() int {
+	return event.Time
+}
+
+// GetMatchID returns the ID of the match the event belongs to.
+func (event MatchStart) GetMatchID() string {
+	return event.MatchID
+}
+
+// GetType returns the telemetry type of the event.
+func (event RoundEvent) GetType() string {
+	return event.Type
+}
+
+// GetCursor returns the position of the event in the telemetry data.
+func (event RoundEvent) GetCursor() int {
+	return event.Cursor
+}
+
+// GetTime returns the time of the event.
+func (event RoundEvent) GetTime() int {
+	return event.Time
+}
+
+// GetMatchID returns the ID of the match the event belongs to.
+func (event RoundEvent) GetMatchID() string {
+	return event.MatchID
+}
+
+// GetType returns the telemetry type of the event.
+func (event UserRoundSpell) GetType() string {
+	return event.Type
+}
+
+// GetCursor returns the position of the event in the telemetry data.
+func (event UserRoundSpell) GetCursor() int {
+	return event.Cursor
+}
+
+// GetTime returns the time of the event.
+func (event UserRoundSpell) GetTime() int {
+	return event.Time
+}
+
+// GetMatchID returns the ID of the match the event belongs to.
+func (event UserRoundSpell) GetMatchID() string {
+	return event.MatchID
+}
+
+// GetType returns the telemetry type of the event.
+func (event DeathEvent) GetType() string {
+	return event.Type
+}
+
+// GetCursor returns the position of the event in the telemetry data.
+func (event DeathEvent) GetCursor() int {
+	return event.Cursor
+}
+
+// GetTime returns the time of the event.
+func (event DeathEvent) GetTime() int {
+	return event.Time
+}
+
+// GetMatchID returns the ID of the match the event belongs to.
+func (event DeathEvent) GetMatchID() string {
+	return event.MatchID
+}
+
+// GetType returns the telemetry type of the event.
+func (event MatchReservedUser) GetType() string {
+	return event.Type
+}
+
+// GetCursor returns the position of the event in the telemetry data.
+func (event MatchReservedUser) GetCursor() int {
+	return event.Cursor
+}
+
+// GetTime returns the time of the event.
+func (event MatchReservedUser) GetTime() int {
+	return event.Time
+}
+
+// GetMatchID returns the ID of the match the event belongs to.
+func (event MatchReservedUser) GetMatchID() string {
+	return event.MatchID
+}
+
+// GetType returns the telemetry type of the event.
+func (event QueueEvent) GetType() string {
+	return event.Type
+}
+
+// GetCursor returns the position of the event in the telemetry data.
+func (event QueueEvent) GetCursor() int {
+	return event.Cursor
+}
+
+// GetTime returns the time of the event.
+func (event QueueEvent) GetTime() int {
+	return event.Time
+}
+
+// GetMatchID returns the ID of the match the event belongs to.
+func (event QueueEvent) GetMatchID() string {
+	return event.MatchID
+}
+
+// GetType returns the telemetry type of the event.
+func (event TeamUpdateEvent) GetType() string {
+	return event.Type
+}
+
+// GetCursor returns the position of the event in the telemetry data.
+func (event TeamUpdateEvent) GetCursor() int {
+	return event.Cursor
+}
+
+// GetTime returns the time of the event.
+func (event TeamUpdateEvent) GetTime() int {
+	return event.Time
+}
+
+// GetMatchID returns the ID of the match the event belongs to.
+func (event TeamUpdateEvent) GetMatchID() string {
+	return event.MatchID
+}
+
+// GetType returns the telemetry type of the event.
+func (event ServerShutdown) GetType() string {
+	return event.Type
+}
+
+// GetCursor returns the position of the event in the telemetry data.
+func (event ServerShutdown) GetCursor() int {
+	return event.Cursor
+}
+
+// GetTime returns the time of the event.
+func (event ServerShutdown) GetTime() int {
+	return event.Time
+}
+
+// GetMatchID returns the ID of the match the event belongs to.
+func (event ServerShutdown) GetMatchID() string {
+	return event.MatchID
+}
+
+// GetType returns the telemetry type of the event.
+func (event RoundFinishedEvent) GetType() string {
+	return event.Type
+}
+
+// GetCursor returns the position of the event in the telemetry data.
+func (event RoundFinishedEvent) GetCursor() int {
+	return event.Cursor
+}
+
+// GetTime returns the time of the event.
+func (event RoundFinishedEvent) GetTime() int {
+	return event.Time
+}
+
+// GetMatchID returns the ID of the match the event belongs to.
+func (event RoundFinishedEvent) GetMatchID() string {
+	return event.MatchID
+}
+
+// GetType returns the telemetry type of the event.
+func (event MatchFinishedEvent) GetType() string {
+	return event.Type
+}
+
+// GetCursor returns the position of the event in the telemetry data.
+func (event MatchFinishedEvent) GetCursor() int {
+	return event.Cursor
+}
+
+// GetTime returns the time of the event.
+func (event MatchFinishedEvent) GetTime() int {
+	return event.Time
+}
+
+// GetMatchID returns the ID of the match the event belongs to.
+func (event MatchFinishedEvent) GetMatchID() string {
+	return event.MatchID
+}
+
+// GetType returns the telemetry type of the event.
+func (event RawTelemetryEvent) GetType() string {
+	return event.Type
+}
+
+// GetCursor returns the position of the event in the telemetry data.
+func (event RawTelemetryEvent) GetCursor() int {
+	return event.Cursor
+}
+
+// GetTime returns the time of the event.
+func (event RawTelemetryEvent) GetTime() int {
+	return event.Time
+}
+
+// GetMatchID returns the ID of the match the event belongs to.
+func (event RawTelemetryEvent) GetMatchID() string {
+	return event.MatchID
+}
+
+// Events returns every event of the telemetry in a single timeline, ordered by cursor.
+// MatchStart, ServerShutdown and MatchFinishedEvent are only included if the telemetry had them.
+// Raw and custom events are included as well.
+func (telemetry Telemetry) Events() []TelemetryEvent {
+	events := []TelemetryEvent{}
+
+	if telemetry.MatchStart.Type != "" {
+		events = append(events, telemetry.MatchStart)
+	}
+	for _, event := range telemetry.RoundEvents {
+		events = append(events, event)
+	}
+	for _, event := range telemetry.UserRoundSpells {
+		events = append(events, event)
+	}
+	for _, event := range telemetry.DeathEvents {
+		events = append(events, event)
+	}
+	for _, event := range telemetry.MatchReservedUsers {
+		events = append(events, event)
+	}
+	for _, event := range telemetry.QueueEvents {
+		events = append(events, event)
+	}
+	for _, event := range telemetry.TeamUpdateEvents {
+		events = append(events, event)
+	}
+	if telemetry.ServerShutdown.Type != "" {
+		events = append(events, telemetry.ServerShutdown)
+	}
+	for _, event := range telemetry.RoundFinishedEvents {
+		events = append(events, event)
+	}
+	if telemetry.MatchFinishedEvent.Type != "" {
+		events = append(events, telemetry.MatchFinishedEvent)
+	}
+	for _, event := range telemetry.RawEvents {
+		events = append(events, event)
+	}
+	events = append(events, telemetry.CustomEvents...)
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].GetCursor() < events[j].GetCursor()
+	})
+
+	return events
+}
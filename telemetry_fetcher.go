@@ -0,0 +1,114 @@
+package battleritego
+
+import (
+	"context"
+	"sync"
+)
+
+// TelemetryResult is the result of downloading the telemetry of a single match.
+type TelemetryResult struct {
+	Match     Match
+	Telemetry Telemetry
+	Err       error
+}
+
+// FetchTelemetry downloads the telemetry of every match with a pool of workers and sends
+// each result on the returned channel as soon as it is ready, so results arrive in no
+// particular order. The channel is closed once every match has been handled.
+// A worker count below one runs a single worker, like the concurrency of LookupPlayers.
+// Telemetry is downloaded from the CDN, which doesn't count against the rate limit of the
+// API, so a low request budget doesn't hold the workers back.
+func (client Client) FetchTelemetry(matches []Match, workers int) <-chan TelemetryResult {
+	return client.FetchTelemetryContext(context.Background(), matches, workers)
+}
+
+// FetchTelemetryContext is like FetchTelemetry but uses ctx for every request.
+// Canceling ctx stops the downloads and closes the channel, results not received by then
+// are dropped.
+func (client Client) FetchTelemetryContext(ctx context.Context, matches []Match, workers int) <-chan TelemetryResult {
+	i := 0
+	next := func() (Match, bool) {
+		if i >= len(matches) {
+			return Match{}, false
+		}
+		i++
+		return matches[i-1], true
+	}
+
+	return client.fetchTelemetry(ctx, next, func() error { return nil }, workers)
+}
+
+// FetchTelemetryFrom is like FetchTelemetry but downloads the telemetry of every match of
+// it, requesting pages as the workers need them, using the context of the iterator.
+// If the iteration fails, a last result with the error and an empty Match is sent.
+func (client Client) FetchTelemetryFrom(it *MatchIterator, workers int) <-chan TelemetryResult {
+	next := func() (Match, bool) {
+		if !it.Next() {
+			return Match{}, false
+		}
+		return it.Match(), true
+	}
+
+	return client.fetchTelemetry(it.ctx, next, it.Err, workers)
+}
+
+// fetchTelemetry runs the worker pool over the matches returned by next, until it returns false.
+// sourceErr is called once next is done and its error, if any, is sent as the last result.
+func (client Client) fetchTelemetry(ctx context.Context, next func() (Match, bool), sourceErr func() error, workers int) <-chan TelemetryResult {
+	if workers < 1 {
+		workers = 1
+	}
+
+	jobs := make(chan Match)
+	results := make(chan TelemetryResult)
+
+	send := func(result TelemetryResult) {
+		select {
+		case results <- result:
+		case <-ctx.Done():
+		}
+	}
+
+	go func() {
+		defer close(jobs)
+		for ctx.Err() == nil {
+			match, ok := next()
+			if !ok {
+				return
+			}
+
+			select {
+			case jobs <- match:
+			case <-ctx.Done():
+			}
+		}
+	}()
+
+	wg := sync.WaitGroup{}
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for match := range jobs {
+				if match.Asset.URL == "" {
+					send(TelemetryResult{Match: match, Err: ErrNoTelemetry})
+					continue
+				}
+
+				telemetry, err := client.GetTelemetryContext(ctx, match.Asset.URL)
+				send(TelemetryResult{Match: match, Telemetry: telemetry, Err: err})
+			}
+		}()
+	}
+
+	go func() {
+		defer close(results)
+		wg.Wait()
+
+		if err := sourceErr(); err != nil {
+			send(TelemetryResult{Err: err})
+		}
+	}()
+
+	return results
+}
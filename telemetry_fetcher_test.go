@@ -0,0 +1,92 @@
+package battleritego
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const testTelemetry = `[{"type":"Structures.DeathEvent","cursor":1,` +
+	`"dataObject":{"time":1,"matchID":"m","externalMatchID":"e","userID":"1"}}]`
+
+func TestFetchTelemetryBeyondRateLimit(t *testing.T) {
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Ratelimit-Limit", "10")
+		w.Header().Set("X-Ratelimit-Remaining", "1")
+		w.Header().Set("X-Ratelimit-Reset", strconv.FormatInt(time.Now().Add(time.Hour).UnixNano(), 10))
+		w.Write([]byte(`{"data":{"type":"status","id":"1","attributes":{}}}`))
+	}))
+	defer api.Close()
+	cdn := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(testTelemetry))
+	}))
+	defer cdn.Close()
+
+	for _, wait := range []bool{false, true} {
+		client := NewClient("key", WithAPIURL(api.URL), WithRateLimitWait(wait))
+		if _, err := client.GetStatus(); err != nil {
+			t.Fatal(err)
+		}
+
+		matches := []Match{}
+		for i := 0; i < 10; i++ {
+			matches = append(matches, Match{ID: strconv.Itoa(i), Asset: Asset{URL: cdn.URL + "/" + strconv.Itoa(i)}})
+		}
+
+		start := time.Now()
+		count := 0
+		for result := range client.FetchTelemetry(matches, 3) {
+			if result.Err != nil {
+				t.Fatalf("match %s: %v", result.Match.ID, result.Err)
+			}
+			count++
+		}
+		if count != len(matches) {
+			t.Errorf("got %d results, want %d", count, len(matches))
+		}
+		if elapsed := time.Since(start); elapsed > 5*time.Second {
+			t.Errorf("fetching took %s", elapsed)
+		}
+
+		if limit, _ := client.RateLimit(); limit.Remaining != 1 {
+			t.Errorf("remaining budget %d, want 1", limit.Remaining)
+		}
+	}
+}
+
+func TestFetchTelemetrySingleWorker(t *testing.T) {
+	var inFlight, maxInFlight int32
+	cdn := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			peak := atomic.LoadInt32(&maxInFlight)
+			if n <= peak || atomic.CompareAndSwapInt32(&maxInFlight, peak, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		w.Write([]byte(testTelemetry))
+	}))
+	defer cdn.Close()
+
+	matches := []Match{}
+	for i := 0; i < 4; i++ {
+		matches = append(matches, Match{ID: strconv.Itoa(i), Asset: Asset{URL: cdn.URL + "/" + strconv.Itoa(i)}})
+	}
+
+	// A worker count below one runs a single worker, like forEachConcurrently.
+	count := 0
+	for result := range NewClient("key").FetchTelemetry(matches, 0) {
+		if result.Err != nil {
+			t.Fatalf("match %s: %v", result.Match.ID, result.Err)
+		}
+		count++
+	}
+	if peak := atomic.LoadInt32(&maxInFlight); count != len(matches) || peak != 1 {
+		t.Errorf("got %d results with up to %d downloads at once, want %d with 1", count, peak, len(matches))
+	}
+}
@@ -0,0 +1,99 @@
+package battleritego
+
+import "io"
+
+// TelemetryHandlers holds the functions called for each telemetry event type by Dispatch.
+// Handlers left nil are skipped, so only the events of interest need a handler.
+// OnEvent is called for every event, after the handler of its type.
+type TelemetryHandlers struct {
+	OnMatchStart        func(MatchStart)
+	OnRoundEvent        func(RoundEvent)
+	OnUserRoundSpell    func(UserRoundSpell)
+	OnDeath             func(DeathEvent)
+	OnMatchReservedUser func(MatchReservedUser)
+	OnQueueEvent        func(QueueEvent)
+	OnTeamUpdate        func(TeamUpdateEvent)
+	OnServerShutdown    func(ServerShutdown)
+	OnRoundFinished     func(RoundFinishedEvent)
+	OnMatchFinished     func(MatchFinishedEvent)
+	OnRawEvent          func(RawTelemetryEvent)
+	OnEvent             func(TelemetryEvent)
+}
+
+// Dispatch calls the handler matching the type of event, followed by OnEvent.
+// Events decoded by a decoder registered with RegisterTelemetryEvent are only passed to OnEvent.
+func (handlers TelemetryHandlers) Dispatch(event TelemetryEvent) {
+	switch event := event.(type) {
+	case MatchStart:
+		if handlers.OnMatchStart != nil {
+			handlers.OnMatchStart(event)
+		}
+	case RoundEvent:
+		if handlers.OnRoundEvent != nil {
+			handlers.OnRoundEvent(event)
+		}
+	case UserRoundSpell:
+		if handlers.OnUserRoundSpell != nil {
+			handlers.OnUserRoundSpell(event)
+		}
+	case DeathEvent:
+		if handlers.OnDeath != nil {
+			handlers.OnDeath(event)
+		}
+	case MatchReservedUser:
+		if handlers.OnMatchReservedUser != nil {
+			handlers.OnMatchReservedUser(event)
+		}
+	case QueueEvent:
+		if handlers.OnQueueEvent != nil {
+			handlers.OnQueueEvent(event)
+		}
+	case TeamUpdateEvent:
+		if handlers.OnTeamUpdate != nil {
+			handlers.OnTeamUpdate(event)
+		}
+	case ServerShutdown:
+		if handlers.OnServerShutdown != nil {
+			handlers.OnServerShutdown(event)
+		}
+	case RoundFinishedEvent:
+		if handlers.OnRoundFinished != nil {
+			handlers.OnRoundFinished(event)
+		}
+	case MatchFinishedEvent:
+		if handlers.OnMatchFinished != nil {
+			handlers.OnMatchFinished(event)
+		}
+	case RawTelemetryEvent:
+		if handlers.OnRawEvent != nil {
+			handlers.OnRawEvent(event)
+		}
+	}
+
+	if handlers.OnEvent != nil {
+		handlers.OnEvent(event)
+	}
+}
+
+// Visit dispatches every event of the telemetry to handlers, ordered by cursor.
+func (telemetry Telemetry) Visit(handlers TelemetryHandlers) {
+	for _, event := range telemetry.Events() {
+		handlers.Dispatch(event)
+	}
+}
+
+// Visit reads the rest of the stream, dispatching each event to handlers as soon as it is read.
+// Events are dispatched in the order they appear in the stream, which is cursor order.
+func (decoder *TelemetryDecoder) Visit(handlers TelemetryHandlers) error {
+	for {
+		event, err := decoder.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		handlers.Dispatch(event)
+	}
+}
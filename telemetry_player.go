@@ -0,0 +1,129 @@
+package battleritego
+
+// PlayerRoundStats are the PlayerStats of a player for a single round.
+type PlayerRoundStats struct {
+	Round       int
+	WinningTeam int
+	PlayerStats
+}
+
+// PlayerSummary joins every telemetry event of a single player of a match.
+// User holds the player's character, team, league and cosmetics from their MatchReservedUser.
+// Totals holds the sum of the player's stats over every round in Rounds.
+type PlayerSummary struct {
+	UserID          string
+	User            MatchReservedUser
+	UserRoundSpells []UserRoundSpell
+	DeathEvents     []DeathEvent
+	RoundEvents     []RoundEvent
+	Rounds          []PlayerRoundStats
+	RoundsWon       int
+	Totals          PlayerStats
+}
+
+// PlayerSummary returns what the player with userID did during the match.
+// The bool is false if the player has no events in the telemetry.
+func (telemetry Telemetry) PlayerSummary(userID string) (PlayerSummary, bool) {
+	summary := PlayerSummary{
+		UserID:          userID,
+		UserRoundSpells: []UserRoundSpell{},
+		DeathEvents:     []DeathEvent{},
+		RoundEvents:     []RoundEvent{},
+		Rounds:          []PlayerRoundStats{},
+		Totals:          PlayerStats{UserID: userID},
+	}
+	found := false
+
+	for _, user := range telemetry.MatchReservedUsers {
+		if user.AccountID == userID {
+			summary.User = user
+			found = true
+		}
+	}
+	for _, event := range telemetry.UserRoundSpells {
+		if event.AccountID == userID {
+			summary.UserRoundSpells = append(summary.UserRoundSpells, event)
+			found = true
+		}
+	}
+	for _, event := range telemetry.DeathEvents {
+		if event.UserID == userID {
+			summary.DeathEvents = append(summary.DeathEvents, event)
+			found = true
+		}
+	}
+	for _, event := range telemetry.RoundEvents {
+		if event.UserID == userID {
+			summary.RoundEvents = append(summary.RoundEvents, event)
+			found = true
+		}
+	}
+
+	for _, round := range telemetry.Rounds() {
+		for _, stats := range round.PlayerStats {
+			if stats.UserID != userID {
+				continue
+			}
+			found = true
+
+			summary.Rounds = append(summary.Rounds, PlayerRoundStats{
+				Round:       round.Round,
+				WinningTeam: round.WinningTeam,
+				PlayerStats: stats,
+			})
+			if summary.User.Type != "" && round.WinningTeam == summary.User.Team {
+				summary.RoundsWon++
+			}
+			summary.Totals.add(stats)
+		}
+	}
+
+	return summary, found
+}
+
+// PlayerSummaries returns a PlayerSummary for every player of the match, in the order of
+// their MatchReservedUser events. Players that only appear in round stats come last.
+func (telemetry Telemetry) PlayerSummaries() []PlayerSummary {
+	userIDs := []string{}
+	seen := map[string]bool{}
+	addUserID := func(userID string) {
+		if userID != "" && !seen[userID] {
+			seen[userID] = true
+			userIDs = append(userIDs, userID)
+		}
+	}
+
+	for _, user := range telemetry.MatchReservedUsers {
+		addUserID(user.AccountID)
+	}
+	for _, event := range telemetry.RoundFinishedEvents {
+		for _, stats := range event.PlayerStats {
+			addUserID(stats.UserID)
+		}
+	}
+
+	summaries := make([]PlayerSummary, 0, len(userIDs))
+	for _, userID := range userIDs {
+		summary, _ := telemetry.PlayerSummary(userID)
+		summaries = append(summaries, summary)
+	}
+
+	return summaries
+}
+
+// add adds the counters of other to stats.
+func (stats *PlayerStats) add(other PlayerStats) {
+	stats.Kills += other.Kills
+	stats.Deaths += other.Deaths
+	stats.Score += other.Score
+	stats.DamageDone += other.DamageDone
+	stats.DamageReceived += other.DamageReceived
+	stats.HealingDone += other.HealingDone
+	stats.HealingReceived += other.HealingReceived
+	stats.DisablesDone += other.DisablesDone
+	stats.DisablesReceived += other.DisablesReceived
+	stats.EnergyGained += other.EnergyGained
+	stats.EnergyUsed += other.EnergyUsed
+	stats.TimeAlive += other.TimeAlive
+	stats.AbilityUses += other.AbilityUses
+}
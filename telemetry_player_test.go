@@ -0,0 +1,51 @@
+package battleritego
+
+import "testing"
+
+func TestPlayerSummary(t *testing.T) {
+	telemetry := newTelemetry()
+	telemetry.MatchReservedUsers = []MatchReservedUser{
+		{Type: "Structures.MatchReservedUser", AccountID: "1", Team: 1, Character: 5},
+	}
+	telemetry.RoundFinishedEvents = []RoundFinishedEvent{
+		{Round: 1, Time: 1000, WinningTeam: 1, PlayerStats: []PlayerStats{
+			{UserID: "1", Kills: 1, DamageDone: 100, TimeAlive: 60},
+			{UserID: "2", Deaths: 1},
+		}},
+		{Round: 2, Time: 2000, WinningTeam: 2, PlayerStats: []PlayerStats{
+			{UserID: "1", Kills: 2, Deaths: 1, DamageDone: 50, TimeAlive: 40},
+			{UserID: "2", Kills: 1},
+		}},
+	}
+	telemetry.DeathEvents = []DeathEvent{{UserID: "1", Time: 1500}}
+
+	summary, ok := telemetry.PlayerSummary("1")
+	if !ok {
+		t.Fatal("PlayerSummary(1) not found")
+	}
+	if summary.User.Character != 5 || len(summary.Rounds) != 2 || len(summary.DeathEvents) != 1 {
+		t.Errorf("PlayerSummary(1) = %+v", summary)
+	}
+	if summary.RoundsWon != 1 {
+		t.Errorf("RoundsWon = %d, want 1", summary.RoundsWon)
+	}
+	want := PlayerStats{UserID: "1", Kills: 3, Deaths: 1, DamageDone: 150, TimeAlive: 100}
+	if summary.Totals != want {
+		t.Errorf("Totals = %+v, want %+v", summary.Totals, want)
+	}
+
+	// Without a MatchReservedUser the team of the player, and so the rounds they won, is unknown.
+	summary, ok = telemetry.PlayerSummary("2")
+	if !ok || summary.RoundsWon != 0 || summary.Totals.Kills != 1 || summary.Totals.Deaths != 1 {
+		t.Errorf("PlayerSummary(2) = %+v, %t", summary, ok)
+	}
+
+	if _, ok := telemetry.PlayerSummary("3"); ok {
+		t.Error("PlayerSummary(3) found a player without events")
+	}
+
+	summaries := telemetry.PlayerSummaries()
+	if len(summaries) != 2 || summaries[0].UserID != "1" || summaries[1].UserID != "2" {
+		t.Errorf("PlayerSummaries() = %+v", summaries)
+	}
+}
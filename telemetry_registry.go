@@ -0,0 +1,102 @@
+package battleritego
+
+import (
+	"encoding/json"
+	"sync"
+)
+
+// TelemetryEventDecoder decodes the data of a single telemetry event into a TelemetryEvent.
+type TelemetryEventDecoder func(data map[string]interface{}) (TelemetryEvent, error)
+
+// telemetryDecoders holds the decoder of each known telemetry event type.
+var telemetryDecoders = struct {
+	sync.RWMutex
+	byType map[string]TelemetryEventDecoder
+}{
+	byType: map[string]TelemetryEventDecoder{
+		"Structures.MatchStart": func(data map[string]interface{}) (TelemetryEvent, error) {
+			return MatchStartFromData(data)
+		},
+		"Structures.RoundEvent": func(data map[string]interface{}) (TelemetryEvent, error) {
+			return RoundEventFromData(data)
+		},
+		"Structures.UserRoundSpell": func(data map[string]interface{}) (TelemetryEvent, error) {
+			return UserRoundSpellFromData(data)
+		},
+		"Structures.DeathEvent": func(data map[string]interface{}) (TelemetryEvent, error) {
+			return DeathEventFromData(data)
+		},
+		"Structures.MatchReservedUser": func(data map[string]interface{}) (TelemetryEvent, error) {
+			return MatchReservedUserFromData(data)
+		},
+		"com.stunlock.service.matchmaking.avro.QueueEvent": func(data map[string]interface{}) (TelemetryEvent, error) {
+			return QueueEventFromData(data)
+		},
+		"com.stunlock.battlerite.team.TeamUpdateEvent": func(data map[string]interface{}) (TelemetryEvent, error) {
+			return TeamUpdateEventFromData(data)
+		},
+		"Structures.ServerShutdown": func(data map[string]interface{}) (TelemetryEvent, error) {
+			return ServerShutdownFromData(data)
+		},
+		"Structures.RoundFinishedEvent": func(data map[string]interface{}) (TelemetryEvent, error) {
+			return RoundFinishedEventFromData(data)
+		},
+		"Structures.MatchFinishedEvent": func(data map[string]interface{}) (TelemetryEvent, error) {
+			return MatchFinishedEventFromData(data)
+		},
+	},
+}
+
+// RegisterTelemetryEvent registers the decoder used for telemetry events of eventType,
+// such as "Structures.MatchStart", replacing any decoder already registered for it.
+// Events decoded by a registered decoder are stored in Telemetry.CustomEvents unless they
+// are one of the event types of this package.
+func RegisterTelemetryEvent(eventType string, decoder TelemetryEventDecoder) {
+	telemetryDecoders.Lock()
+	defer telemetryDecoders.Unlock()
+
+	telemetryDecoders.byType[eventType] = decoder
+}
+
+// lookupTelemetryEvent returns the decoder registered for eventType.
+func lookupTelemetryEvent(eventType string) (TelemetryEventDecoder, bool) {
+	telemetryDecoders.RLock()
+	defer telemetryDecoders.RUnlock()
+
+	decoder, ok := telemetryDecoders.byType[eventType]
+	return decoder, ok
+}
+
+// RawTelemetryEvent is a telemetry event of a type without a registered decoder,
+// such as an event added by a game patch.
+// Data holds the whole event as it was read, so it can be decoded later.
+type RawTelemetryEvent struct {
+	Type    string
+	Cursor  int
+	Time    int
+	MatchID string
+	Data    json.RawMessage
+}
+
+// RawTelemetryEventFromData returns a RawTelemetryEvent from data and the raw JSON it was decoded from.
+// Every field is read leniently, so an unknown event is kept even if it lacks a type, cursor,
+// time or match ID, which are left zero. The error is always nil.
+func RawTelemetryEventFromData(data map[string]interface{}, raw json.RawMessage) (RawTelemetryEvent, error) {
+	dataObject, _ := data["dataObject"].(map[string]interface{})
+
+	eventType, _ := data["type"].(string)
+	cursor, _ := data["cursor"].(float64)
+	time, _ := dataObject["time"].(float64)
+	matchID, _ := dataObject["matchID"].(string)
+	if matchID == "" {
+		matchID, _ = dataObject["matchId"].(string)
+	}
+
+	return RawTelemetryEvent{
+		Type:    eventType,
+		Cursor:  int(cursor),
+		Time:    int(time),
+		MatchID: matchID,
+		Data:    raw,
+	}, nil
+}
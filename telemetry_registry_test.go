@@ -0,0 +1,33 @@
+package battleritego
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDecodeTelemetryKeepsUnknownEvents(t *testing.T) {
+	body := `[
+		{"type":"Structures.PowerUpEvent","cursor":2,"dataObject":{"time":5,"matchID":"m","powerUp":3}},
+		{"type":"Structures.NoCursorEvent","dataObject":"not an object"},
+		{"cursor":"4"}
+	]`
+
+	telemetry, err := DecodeTelemetry(strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("DecodeTelemetry() error = %v", err)
+	}
+	if len(telemetry.RawEvents) != 3 {
+		t.Fatalf("kept %d raw events, want 3", len(telemetry.RawEvents))
+	}
+
+	event := telemetry.RawEvents[0]
+	if event.Type != "Structures.PowerUpEvent" || event.Cursor != 2 || event.Time != 5 || event.MatchID != "m" {
+		t.Errorf("raw event = %+v", event)
+	}
+	if !strings.Contains(string(event.Data), `"powerUp":3`) {
+		t.Errorf("raw event data = %s", event.Data)
+	}
+	if event := telemetry.RawEvents[1]; event.Type != "Structures.NoCursorEvent" || event.Cursor != 0 {
+		t.Errorf("raw event without cursor = %+v", event)
+	}
+}
@@ -0,0 +1,149 @@
+package battleritego
+
+import "sort"
+
+// roundLengthMillis is the number of milliseconds in a unit of RoundFinishedEvent.RoundLength,
+// which is given in seconds while event times are in milliseconds.
+const roundLengthMillis = 1000
+
+// TelemetryRound groups the telemetry events of a single round of a match.
+// Start and End are the times in milliseconds the round began and finished, Length is
+// in seconds. Start is End minus Length, so it excludes the countdown before the round.
+// A round that has no RoundFinishedEvent, such as the last round of a match that was cut
+// short, has Finished set to false and an End of 0. As it has no Length either, its Start
+// is the time of its first RoundEvent or UserRoundSpell, which are only sent once the round
+// is being played. Only a round without any of those starts when the round before it
+// finished, or the match started, so its Start includes the countdown.
+type TelemetryRound struct {
+	Round              int
+	Start              int
+	End                int
+	Length             int
+	WinningTeam        int
+	Finished           bool
+	PlayerStats        []PlayerStats
+	RoundEvents        []RoundEvent
+	UserRoundSpells    []UserRoundSpell
+	DeathEvents        []DeathEvent
+	RoundFinishedEvent RoundFinishedEvent
+}
+
+// Rounds returns the events of the telemetry grouped by round, ordered by round number.
+// RoundEvents and UserRoundSpells are grouped by their Round. DeathEvents carry no round,
+// so they are placed in the round whose RoundFinishedEvent is the first at or after the time
+// of the death. Deaths after the last RoundFinishedEvent belong to the round after it.
+func (telemetry Telemetry) Rounds() []TelemetryRound {
+	rounds := map[int]*TelemetryRound{}
+	round := func(number int) *TelemetryRound {
+		if rounds[number] == nil {
+			rounds[number] = &TelemetryRound{
+				Round:           number,
+				PlayerStats:     []PlayerStats{},
+				RoundEvents:     []RoundEvent{},
+				UserRoundSpells: []UserRoundSpell{},
+				DeathEvents:     []DeathEvent{},
+			}
+		}
+		return rounds[number]
+	}
+
+	finished := append([]RoundFinishedEvent{}, telemetry.RoundFinishedEvents...)
+	sort.SliceStable(finished, func(i, j int) bool {
+		return finished[i].Time < finished[j].Time
+	})
+
+	for _, event := range finished {
+		r := round(event.Round)
+		r.End = event.Time
+		r.Length = event.RoundLength
+		r.WinningTeam = event.WinningTeam
+		r.Finished = true
+		r.PlayerStats = event.PlayerStats
+		r.RoundFinishedEvent = event
+	}
+	for _, event := range telemetry.RoundEvents {
+		r := round(event.Round)
+		r.RoundEvents = append(r.RoundEvents, event)
+	}
+	for _, event := range telemetry.UserRoundSpells {
+		r := round(event.Round)
+		r.UserRoundSpells = append(r.UserRoundSpells, event)
+	}
+
+	deaths := append([]DeathEvent{}, telemetry.DeathEvents...)
+	sort.SliceStable(deaths, func(i, j int) bool {
+		return deaths[i].Time < deaths[j].Time
+	})
+	for _, event := range deaths {
+		r := round(roundAtTime(finished, rounds, event.Time))
+		r.DeathEvents = append(r.DeathEvents, event)
+	}
+
+	result := make([]TelemetryRound, 0, len(rounds))
+	for _, r := range rounds {
+		result = append(result, *r)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Round < result[j].Round
+	})
+
+	// Unfinished rounds without events start when the one before them finished, the first
+	// when the match started.
+	start := 0
+	if telemetry.MatchStart.Type != "" {
+		start = telemetry.MatchStart.Time
+	}
+	for i := range result {
+		if !result[i].Finished {
+			result[i].Start = firstEventTime(result[i], start)
+			continue
+		}
+		result[i].Start = result[i].End - result[i].Length*roundLengthMillis
+		start = result[i].End
+	}
+
+	return result
+}
+
+// firstEventTime returns the time of the earliest RoundEvent or UserRoundSpell of the
+// round, or fallback if it has none.
+func firstEventTime(round TelemetryRound, fallback int) int {
+	first, found := 0, false
+	for _, event := range round.RoundEvents {
+		if !found || event.Time < first {
+			first, found = event.Time, true
+		}
+	}
+	for _, event := range round.UserRoundSpells {
+		if !found || event.Time < first {
+			first, found = event.Time, true
+		}
+	}
+	if !found {
+		return fallback
+	}
+	return first
+}
+
+// roundAtTime returns the number of the round that was being played at time, given the
+// RoundFinishedEvents sorted by time.
+func roundAtTime(finished []RoundFinishedEvent, rounds map[int]*TelemetryRound, time int) int {
+	i := sort.Search(len(finished), func(i int) bool {
+		return finished[i].Time >= time
+	})
+	if i < len(finished) {
+		return finished[i].Round
+	}
+	if len(finished) > 0 {
+		return finished[len(finished)-1].Round + 1
+	}
+
+	// Without any finished rounds, use the highest round seen in the other events.
+	number := 1
+	for n := range rounds {
+		if n > number {
+			number = n
+		}
+	}
+	return number
+}
@@ -0,0 +1,95 @@
+package battleritego
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// TelemetryDiscrepancy is a difference found by ValidateTelemetry between a Match and its telemetry.
+// Field names what was compared, such as "rounds" or "player 123 kills".
+// Match and Telemetry hold the value found in each.
+type TelemetryDiscrepancy struct {
+	Field     string
+	Match     interface{}
+	Telemetry interface{}
+}
+
+// Error returns a description of the discrepancy.
+func (discrepancy TelemetryDiscrepancy) Error() string {
+	return fmt.Sprintf("%s: match has %v, telemetry has %v", discrepancy.Field, discrepancy.Match, discrepancy.Telemetry)
+}
+
+// ValidateTelemetry cross checks match against the telemetry fetched from match.Asset.URL
+// and returns every discrepancy found, or an empty slice if they agree.
+// It compares match IDs, round counts, round winners and the kills, deaths, damage and healing
+// of each participant against the totals of their round stats, so truncated or corrupt
+// telemetry can be detected.
+func ValidateTelemetry(match Match, telemetry Telemetry) []TelemetryDiscrepancy {
+	discrepancies := []TelemetryDiscrepancy{}
+	report := func(field string, matchValue, telemetryValue interface{}) {
+		discrepancies = append(discrepancies, TelemetryDiscrepancy{field, matchValue, telemetryValue})
+	}
+
+	if telemetry.MatchStart.Type == "" {
+		report("match start", match.ID, "missing")
+	} else if !strings.EqualFold(telemetry.MatchStart.MatchID, match.ID) {
+		report("match start match ID", match.ID, telemetry.MatchStart.MatchID)
+	}
+	if telemetry.MatchFinishedEvent.Type == "" {
+		report("match finished", match.ID, "missing")
+	} else if !strings.EqualFold(telemetry.MatchFinishedEvent.MatchID, match.ID) {
+		report("match finished match ID", match.ID, telemetry.MatchFinishedEvent.MatchID)
+	}
+
+	// Rounds are paired by their order, as the match numbers them by ordinal.
+	matchRounds := append([]Round{}, match.Rounds...)
+	sort.SliceStable(matchRounds, func(i, j int) bool {
+		return matchRounds[i].Ordinal < matchRounds[j].Ordinal
+	})
+	telemetryRounds := append([]RoundFinishedEvent{}, telemetry.RoundFinishedEvents...)
+	sort.SliceStable(telemetryRounds, func(i, j int) bool {
+		return telemetryRounds[i].Round < telemetryRounds[j].Round
+	})
+
+	if len(matchRounds) != len(telemetryRounds) {
+		report("rounds", len(matchRounds), len(telemetryRounds))
+	}
+	for i := 0; i < len(matchRounds) && i < len(telemetryRounds); i++ {
+		if matchRounds[i].WinningTeam != telemetryRounds[i].WinningTeam {
+			field := fmt.Sprintf("round %d winning team", matchRounds[i].Ordinal)
+			report(field, matchRounds[i].WinningTeam, telemetryRounds[i].WinningTeam)
+		}
+	}
+
+	participants := map[string]bool{}
+	for _, participant := range match.Participants {
+		userID := strconv.Itoa(participant.UserID)
+		participants[userID] = true
+
+		summary, ok := telemetry.PlayerSummary(userID)
+		if !ok {
+			report("player "+userID, "present", "missing")
+			continue
+		}
+
+		compare := func(stat string, matchValue, telemetryValue int) {
+			if matchValue != telemetryValue {
+				report("player "+userID+" "+stat, matchValue, telemetryValue)
+			}
+		}
+		compare("kills", participant.Kills, summary.Totals.Kills)
+		compare("deaths", participant.Deaths, summary.Totals.Deaths)
+		compare("damage done", participant.DamageDone, summary.Totals.DamageDone)
+		compare("damage received", participant.DamageReceived, summary.Totals.DamageReceived)
+		compare("healing done", participant.HealingDone, summary.Totals.HealingDone)
+	}
+	for _, summary := range telemetry.PlayerSummaries() {
+		if !participants[summary.UserID] {
+			report("player "+summary.UserID, "missing", "present")
+		}
+	}
+
+	return discrepancies
+}
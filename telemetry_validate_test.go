@@ -0,0 +1,62 @@
+package battleritego
+
+import "testing"
+
+func TestValidateTelemetry(t *testing.T) {
+	match := Match{
+		ID: "M1",
+		Rounds: []Round{
+			{Ordinal: 2, WinningTeam: 1},
+			{Ordinal: 1, WinningTeam: 1},
+		},
+		Participants: []Participant{
+			{UserID: 1, Kills: 2, Deaths: 1, DamageDone: 300, HealingDone: 50},
+			{UserID: 3},
+		},
+	}
+	telemetry := newTelemetry()
+	telemetry.MatchStart = MatchStart{Type: "Structures.MatchStart", MatchID: "m1"}
+	telemetry.MatchFinishedEvent = MatchFinishedEvent{Type: "Structures.MatchFinishedEvent", MatchID: "m1"}
+	telemetry.RoundFinishedEvents = []RoundFinishedEvent{
+		{Round: 1, WinningTeam: 1, PlayerStats: []PlayerStats{
+			{UserID: "1", Kills: 1, DamageDone: 100, HealingDone: 50},
+			{UserID: "3"},
+		}},
+		{Round: 2, WinningTeam: 1, PlayerStats: []PlayerStats{
+			{UserID: "1", Kills: 1, Deaths: 1, DamageDone: 200},
+			{UserID: "3"},
+		}},
+	}
+
+	// Match IDs are compared case insensitively.
+	if discrepancies := ValidateTelemetry(match, telemetry); len(discrepancies) != 0 {
+		t.Fatalf("ValidateTelemetry() = %v, want none", discrepancies)
+	}
+
+	telemetry.MatchFinishedEvent.MatchID = "m2"
+	telemetry.RoundFinishedEvents = append(telemetry.RoundFinishedEvents, RoundFinishedEvent{
+		Round: 3, WinningTeam: 2, PlayerStats: []PlayerStats{{UserID: "1", Kills: 1}, {UserID: "4"}},
+	})
+	telemetry.RoundFinishedEvents[1].WinningTeam = 2
+	match.Participants = append(match.Participants, Participant{UserID: 5})
+
+	got := map[string]bool{}
+	for _, discrepancy := range ValidateTelemetry(match, telemetry) {
+		got[discrepancy.Field] = true
+	}
+	for _, field := range []string{
+		"match finished match ID",
+		"rounds",
+		"round 2 winning team",
+		"player 1 kills",
+		"player 5",
+		"player 4",
+	} {
+		if !got[field] {
+			t.Errorf("no discrepancy for %q in %v", field, got)
+		}
+	}
+	if len(got) != 6 {
+		t.Errorf("ValidateTelemetry() found %d discrepancies, want 6: %v", len(got), got)
+	}
+}